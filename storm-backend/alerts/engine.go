@@ -0,0 +1,433 @@
+package alerts
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/hub"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/subscription"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// Ключи Redis, общие для всех реплик: каждое обновление получают все реплики, а обрабатывает одна
+const (
+	stateKey   = "alert:state:%s"   // HASH: seq — последнее обработанное обновление, rule:<id> — состояние правила
+	samplesKey = "alert:samples:%s" // ZSET замеров региона для rise/fall; score — время замера (unix ms)
+	lockKey    = "alert:lock:%s"    // Блокировка на время обработки обновления
+	lockTTL    = 10 * time.Second
+
+	MaxWindow = 24 * time.Hour // Самое длинное окно rise/fall; замеры старше удаляются
+
+	holdEvery = time.Minute // Как часто сверять регионы с правилами и удерживаемые подписки
+)
+
+// Каналы pub/sub событий оповещений: EventsPrefix + subject пользователя
+const EventsPrefix = "alert_events:"
+
+// Снятие блокировки, только если она всё ещё наша
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// Движок правил: проверяет правила региона на каждом обновлении weather-worker
+// и сохраняет срабатывания и снятия оповещений
+type Engine struct {
+	store   database.Store
+	rdb     *redis.Client
+	updates *hub.Hub // Те же каналы storm_updates:<регион>, что получают потоки клиентов
+
+	Notifier      Notifier               // Необязательная внешняя доставка оповещений (webhooks)
+	Subscriptions *subscription.Registry // Если задан, регионы с включёнными правилами опрашиваются и без клиентов
+}
+
+// Получатель оповещений помимо Redis, например очередь webhooks
+type Notifier interface {
+	NotifyAlert(ctx context.Context, event models.AlertEvent)
+}
+
+func NewEngine(store database.Store, rdb *redis.Client, updates *hub.Hub) *Engine {
+	return &Engine{store: store, rdb: rdb, updates: updates}
+}
+
+// Состояние правила между обновлениями
+type ruleState struct {
+	Streak  int   `json:"streak"`             // Обновлений подряд, на которых условие выполнялось
+	Firing  bool  `json:"firing"`             // Оповещение активно; повторно не отправляется до снятия
+	FiredAt int64 `json:"fired_at,omitempty"` // Последнее срабатывание (unix ms) для cool-down
+}
+
+// Замер региона в окне rise/fall
+type sample struct {
+	Seq      uint64  `json:"seq"`
+	At       int64   `json:"at"`
+	WindKmH  float64 `json:"wind_kmh"`
+	Temp     float64 `json:"temp"`
+	Humidity float64 `json:"humidity"`
+	Pressure float64 `json:"pressure,omitempty"`
+}
+
+// Подписка на обновления регионов до отмены контекста
+func (e *Engine) Run(ctx context.Context, regions []string) {
+	var wg sync.WaitGroup
+	for _, region := range regions {
+		sub, err := e.updates.Subscribe(ctx, region)
+		if err != nil {
+			log.Error().Err(err).Str("region", region).Msg("Alert engine failed to subscribe to region updates")
+			continue
+		}
+		wg.Add(1)
+		go func(region string, sub *hub.Subscriber) {
+			defer wg.Done()
+			defer sub.Close()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case payload, ok := <-sub.Updates():
+					if !ok {
+						return
+					}
+					var data models.CacheData
+					if err := json.Unmarshal([]byte(payload), &data); err != nil {
+						log.Error().Err(err).Str("region", region).Msg("Alert engine failed to decode weather update")
+						continue
+					}
+					if err := e.Evaluate(ctx, region, data); err != nil {
+						log.Error().Err(err).Str("region", region).Uint64("sequence", data.Sequence).Msg("Failed to evaluate alert rules")
+					}
+				}
+			}
+		}(region, sub)
+	}
+	if e.Subscriptions != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.Subscriptions.Hold(ctx, "system:alerts", holdEvery, func(ctx context.Context) ([]string, error) {
+				return e.ruleRegions(ctx, regions)
+			})
+		}()
+	}
+	log.Info().Int("regions", len(regions)).Msg("Alert engine started")
+	wg.Wait()
+}
+
+// Регионы, у которых есть включённые правила: без опроса их обновления не приходят
+func (e *Engine) ruleRegions(ctx context.Context, regions []string) ([]string, error) {
+	var needed []string
+	for _, region := range regions {
+		rules, err := e.store.RegionAlertRules(ctx, region)
+		if err != nil {
+			return nil, err
+		}
+		if len(rules) > 0 {
+			needed = append(needed, region)
+		}
+	}
+	return needed, nil
+}
+
+// Проверка правил региона на одном обновлении
+func (e *Engine) Evaluate(ctx context.Context, region string, data models.CacheData) error {
+	unlock, err := e.lock(ctx, region)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	key := fmt.Sprintf(stateKey, region)
+	values, err := e.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("failed to load alert state for %s: %w", region, err)
+	}
+	at, err := time.Parse(time.RFC3339, data.Timestamp)
+	if err != nil {
+		at = time.Now()
+	}
+	at = at.UTC()
+	// Обновление уже обработано другой репликой или опоздало. Меньший номер с более поздним временем
+	// означает, что счётчик воркера обнулился, и такое обновление обрабатывается
+	last, _ := strconv.ParseUint(values["seq"], 10, 64)
+	lastAt, _ := time.Parse(time.RFC3339, values["timestamp"])
+	if !at.After(lastAt) && (data.Sequence == 0 || data.Sequence <= last) {
+		return nil
+	}
+
+	rules, err := e.store.RegionAlertRules(ctx, region)
+	if err != nil {
+		return err
+	}
+	current := sample{
+		Seq:      data.Sequence,
+		At:       at.UnixMilli(),
+		WindKmH:  float64(data.WindKmH),
+		Temp:     float64(data.Temp),
+		Humidity: float64(data.Humidity),
+		Pressure: float64(data.Pressure),
+	}
+
+	var history []sample
+	if needsHistory(rules) {
+		if history, err = e.history(ctx, region, current); err != nil {
+			return err
+		}
+	}
+
+	updates := []interface{}{"seq", data.Sequence, "timestamp", at.Format(time.RFC3339)}
+	active := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		field := "rule:" + strconv.FormatInt(rule.ID, 10)
+		active[field] = true
+		var st ruleState
+		if raw := values[field]; raw != "" {
+			_ = json.Unmarshal([]byte(raw), &st) // Испорченное состояние начинается заново
+		}
+
+		event := e.step(rule, &st, current, history, at)
+		if event != nil {
+			event.Sequence = data.Sequence
+			e.emit(ctx, event)
+		}
+		encoded, _ := json.Marshal(st)
+		updates = append(updates, field, string(encoded))
+	}
+
+	pipe := e.rdb.TxPipeline()
+	pipe.HSet(ctx, key, updates...)
+	// Состояния удалённых и выключенных правил больше не нужны
+	for field := range values {
+		if strings.HasPrefix(field, "rule:") && !active[field] {
+			pipe.HDel(ctx, key, field)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save alert state for %s: %w", region, err)
+	}
+	return nil
+}
+
+// Шаг правила на одном замере; возвращает событие, если состояние оповещения изменилось
+func (e *Engine) step(rule models.AlertRule, st *ruleState, current sample, history []sample, at time.Time) *models.AlertEvent {
+	value, matched, ok := measure(rule, current, history)
+	if !ok { // Показатель не сообщался — состояние правила не меняется
+		return nil
+	}
+	if matched {
+		st.Streak++
+	} else {
+		st.Streak = 0
+	}
+
+	switch {
+	case st.Firing && !matched:
+		st.Firing = false
+		return newEvent(rule, models.AlertResolved, value, resolvedMessage(rule, value), at)
+	case !st.Firing && matched && st.Streak >= max(rule.ForUpdates, 1):
+		// Cool-down: условие снова выполнилось слишком скоро после прошлого срабатывания
+		if st.FiredAt > 0 && at.Sub(time.UnixMilli(st.FiredAt)) < rule.Cooldown {
+			return nil
+		}
+		st.Firing = true
+		st.FiredAt = at.UnixMilli()
+		return newEvent(rule, models.AlertFiring, value, firingMessage(rule, value), at)
+	}
+	return nil
+}
+
+// Значение показателя (для rise/fall — изменение за окно) и выполнение условия правила
+func measure(rule models.AlertRule, current sample, history []sample) (value float64, matched, ok bool) {
+	now, ok := metric(rule.Metric, current)
+	if !ok {
+		return 0, false, false
+	}
+	switch rule.Operator {
+	case models.OperatorGT:
+		return now, now > rule.Value, true
+	case models.OperatorGTE:
+		return now, now >= rule.Value, true
+	case models.OperatorLT:
+		return now, now < rule.Value, true
+	case models.OperatorLTE:
+		return now, now <= rule.Value, true
+	case models.OperatorRise, models.OperatorFall:
+		// Изменение относительно минимума (rise) или максимума (fall) за окно
+		from := current.At - rule.Window.Milliseconds()
+		var change float64
+		for _, s := range history {
+			if s.At < from || s.At > current.At {
+				continue
+			}
+			past, ok := metric(rule.Metric, s)
+			if !ok {
+				continue
+			}
+			if rule.Operator == models.OperatorRise {
+				change = max(change, now-past)
+			} else {
+				change = max(change, past-now)
+			}
+		}
+		return change, change >= rule.Value, true
+	}
+	return 0, false, false
+}
+
+// Значение показателя замера; давление 0 означает, что провайдер его не сообщил
+func metric(name string, s sample) (float64, bool) {
+	switch name {
+	case models.MetricWindKmH:
+		return s.WindKmH, true
+	case models.MetricTemp:
+		return s.Temp, true
+	case models.MetricHumidity:
+		return s.Humidity, true
+	case models.MetricPressure:
+		return s.Pressure, s.Pressure != 0
+	}
+	return 0, false
+}
+
+func needsHistory(rules []models.AlertRule) bool {
+	for _, rule := range rules {
+		if rule.Operator == models.OperatorRise || rule.Operator == models.OperatorFall {
+			return true
+		}
+	}
+	return false
+}
+
+// Добавление замера в окно региона и чтение окна за MaxWindow
+func (e *Engine) history(ctx context.Context, region string, current sample) ([]sample, error) {
+	key := fmt.Sprintf(samplesKey, region)
+	member, _ := json.Marshal(current)
+	from := current.At - MaxWindow.Milliseconds()
+
+	pipe := e.rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(current.At), Member: string(member)})
+	pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatInt(from, 10))
+	pipe.Expire(ctx, key, MaxWindow)
+	window := pipe.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: strconv.FormatInt(from, 10), Max: "+inf"})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update alert samples for %s: %w", region, err)
+	}
+
+	members := window.Val()
+	history := make([]sample, 0, len(members))
+	for _, raw := range members {
+		var s sample
+		if err := json.Unmarshal([]byte(raw), &s); err == nil {
+			history = append(history, s)
+		}
+	}
+	return history, nil
+}
+
+func newEvent(rule models.AlertRule, state string, value float64, message string, at time.Time) *models.AlertEvent {
+	return &models.AlertEvent{
+		RuleID:     rule.ID,
+		UserID:     rule.UserID,
+		RuleName:   rule.Name,
+		Region:     rule.Region,
+		State:      state,
+		Value:      value,
+		Message:    message,
+		OccurredAt: at,
+	}
+}
+
+var operatorSymbols = map[string]string{
+	models.OperatorGT:  ">",
+	models.OperatorGTE: ">=",
+	models.OperatorLT:  "<",
+	models.OperatorLTE: "<=",
+}
+
+// Например "wind_kmh 95 > 90 in Atlantic" или "humidity rose by 22 in 30m0s in Atlantic"
+func firingMessage(rule models.AlertRule, value float64) string {
+	switch rule.Operator {
+	case models.OperatorRise:
+		return fmt.Sprintf("%s rose by %s in %s in %s", rule.Metric, format(value), rule.Window, rule.Region)
+	case models.OperatorFall:
+		return fmt.Sprintf("%s fell by %s in %s in %s", rule.Metric, format(value), rule.Window, rule.Region)
+	}
+	return fmt.Sprintf("%s %s %s %s in %s", rule.Metric, format(value), operatorSymbols[rule.Operator], format(rule.Value), rule.Region)
+}
+
+func resolvedMessage(rule models.AlertRule, value float64) string {
+	if rule.Operator == models.OperatorRise || rule.Operator == models.OperatorFall {
+		return fmt.Sprintf("%s changed by %s in %s in %s, below %s", rule.Metric, format(value), rule.Window, rule.Region, format(rule.Value))
+	}
+	return fmt.Sprintf("%s back to %s in %s", rule.Metric, format(value), rule.Region)
+}
+
+func format(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// Сохранение события и публикация его владельцу правила. Ошибки только логируются:
+// состояние правила уже изменилось, а повтор обновления не должен дублировать оповещение
+func (e *Engine) emit(ctx context.Context, event *models.AlertEvent) {
+	if err := e.store.AddAlertEvent(ctx, event); err != nil {
+		log.Error().Err(err).Int64("rule", event.RuleID).Msg("Failed to save alert event")
+	}
+	log.Info().Int64("rule", event.RuleID).Str("user", event.UserID).Str("region", event.Region).Str("state", event.State).Msg(event.Message)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Int64("rule", event.RuleID).Msg("Failed to encode alert event")
+		return
+	}
+	if err := e.rdb.Publish(ctx, EventsPrefix+event.UserID, payload).Err(); err != nil {
+		log.Error().Err(err).Int64("rule", event.RuleID).Msg("Failed to publish alert event")
+	}
+	if e.Notifier != nil {
+		e.Notifier.NotifyAlert(ctx, *event)
+	}
+}
+
+// Блокировка региона, чтобы одно обновление не обрабатывалось параллельно в разных репликах
+func (e *Engine) lock(ctx context.Context, region string) (func(), error) {
+	key := fmt.Sprintf(lockKey, region)
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	token := hex.EncodeToString(buf)
+
+	deadline := time.Now().Add(lockTTL)
+	for {
+		ok, err := e.rdb.SetNX(ctx, key, token, lockTTL).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock alert rules for %s: %w", region, err)
+		}
+		if ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for alert lock of %s", region)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	return func() {
+		if err := unlockScript.Run(context.Background(), e.rdb, []string{key}, token).Err(); err != nil && err != redis.Nil {
+			log.Error().Err(err).Str("region", region).Msg("Failed to unlock alert rules")
+		}
+	}, nil
+}
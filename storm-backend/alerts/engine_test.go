@@ -0,0 +1,145 @@
+package alerts
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+var start = time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+
+// Замер через minutes минут после start
+func at(minutes int, s sample) sample {
+	s.At = start.Add(time.Duration(minutes) * time.Minute).UnixMilli()
+	return s
+}
+
+func TestMeasure(t *testing.T) {
+	history := []sample{
+		at(-90, sample{Humidity: 10, Pressure: 990}), // Вне 60-минутного окна
+		at(-50, sample{Humidity: 60, Pressure: 1012}),
+		at(-30, sample{Humidity: 55}), // Давление не сообщалось
+		at(-10, sample{Humidity: 70, Pressure: 1008}),
+		at(10, sample{Humidity: 0, Pressure: 900}), // Позже текущего замера
+	}
+	current := at(0, sample{WindKmH: 90, Temp: 25, Humidity: 75, Pressure: 1000})
+
+	tests := []struct {
+		name     string
+		rule     models.AlertRule
+		current  sample
+		value    float64
+		matched  bool
+		reported bool
+	}{
+		{"gt at the threshold", models.AlertRule{Metric: models.MetricWindKmH, Operator: models.OperatorGT, Value: 90}, current, 90, false, true},
+		{"gte at the threshold", models.AlertRule{Metric: models.MetricWindKmH, Operator: models.OperatorGTE, Value: 90}, current, 90, true, true},
+		{"lt", models.AlertRule{Metric: models.MetricTemp, Operator: models.OperatorLT, Value: 30}, current, 25, true, true},
+		{"lte below", models.AlertRule{Metric: models.MetricTemp, Operator: models.OperatorLTE, Value: 24.9}, current, 25, false, true},
+		{"pressure", models.AlertRule{Metric: models.MetricPressure, Operator: models.OperatorLT, Value: 1005}, current, 1000, true, true},
+		{"missing pressure", models.AlertRule{Metric: models.MetricPressure, Operator: models.OperatorLT, Value: 1005}, at(0, sample{WindKmH: 90}), 0, false, false},
+		{"unknown metric", models.AlertRule{Metric: "visibility", Operator: models.OperatorGT, Value: 1}, current, 0, false, false},
+		{"unknown operator", models.AlertRule{Metric: models.MetricWindKmH, Operator: "between", Value: 1}, current, 0, false, false},
+		// Рост от минимума окна (55 за 30 минут до), замер 90 минут назад уже вне окна
+		{"rise from the window minimum", models.AlertRule{Metric: models.MetricHumidity, Operator: models.OperatorRise, Value: 20, Window: time.Hour}, current, 20, true, true},
+		{"rise in a shorter window", models.AlertRule{Metric: models.MetricHumidity, Operator: models.OperatorRise, Value: 20, Window: 20 * time.Minute}, current, 5, false, true},
+		// Падение от максимума окна; замер без давления пропускается, а не считается нулём
+		{"fall from the window maximum", models.AlertRule{Metric: models.MetricPressure, Operator: models.OperatorFall, Value: 10, Window: time.Hour}, current, 12, true, true},
+		{"fall without pressure now", models.AlertRule{Metric: models.MetricPressure, Operator: models.OperatorFall, Value: 10, Window: time.Hour}, at(0, sample{}), 0, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, matched, ok := measure(tt.rule, tt.current, history)
+			if math.Abs(value-tt.value) > 1e-9 || matched != tt.matched || ok != tt.reported {
+				t.Errorf("measure = %v, %v, %v, want %v, %v, %v", value, matched, ok, tt.value, tt.matched, tt.reported)
+			}
+		})
+	}
+
+	// Без истории изменения нет, но показатель сообщён — правило не срабатывает и может сняться
+	rise := models.AlertRule{Metric: models.MetricHumidity, Operator: models.OperatorRise, Value: 1, Window: time.Hour}
+	if value, matched, ok := measure(rise, current, nil); value != 0 || matched || !ok {
+		t.Errorf("rise without history = %v, %v, %v", value, matched, ok)
+	}
+}
+
+// Шаг правила по замерам через каждые 10 минут; want — ожидаемое событие на каждом шаге
+func runSteps(t *testing.T, rule models.AlertRule, st *ruleState, values []float64, want []string) {
+	t.Helper()
+	e := &Engine{}
+	for i, v := range values {
+		current := at(10*i, sample{WindKmH: v, Pressure: v})
+		event := e.step(rule, st, current, nil, time.UnixMilli(current.At).UTC())
+		got := ""
+		if event != nil {
+			got = event.State
+			if event.RuleID != rule.ID || event.Value != v || !event.OccurredAt.Equal(time.UnixMilli(current.At)) {
+				t.Errorf("step %d: event = %+v", i, event)
+			}
+		}
+		if got != want[i] {
+			t.Errorf("step %d (%v): event %q, want %q (state %+v)", i, v, got, want[i], *st)
+		}
+	}
+}
+
+func TestStepForUpdates(t *testing.T) {
+	rule := models.AlertRule{ID: 1, Metric: models.MetricWindKmH, Operator: models.OperatorGT, Value: 90, ForUpdates: 3}
+	// Серия прерывается на 80, срабатывание — на третьем подряд, повтор не срабатывает
+	st := &ruleState{}
+	runSteps(t, rule, st,
+		[]float64{95, 95, 80, 95, 95, 95, 99, 80, 80},
+		[]string{"", "", "", "", "", models.AlertFiring, "", models.AlertResolved, ""})
+	if st.Firing || st.Streak != 0 {
+		t.Errorf("state after resolve = %+v", *st)
+	}
+
+	// for_updates 0 значит «с первого же обновления»
+	rule.ForUpdates = 0
+	runSteps(t, rule, &ruleState{}, []float64{91, 50}, []string{models.AlertFiring, models.AlertResolved})
+}
+
+func TestStepCooldown(t *testing.T) {
+	rule := models.AlertRule{ID: 2, Metric: models.MetricWindKmH, Operator: models.OperatorGT, Value: 90, ForUpdates: 1, Cooldown: 45 * time.Minute}
+	// Сработало в 0 мин; через 20 и 30 мин условие снова выполняется, но cool-down ещё идёт;
+	// в 50 мин cool-down закончился и серия не прерывалась
+	st := &ruleState{}
+	runSteps(t, rule, st,
+		[]float64{95, 80, 95, 95, 95, 95},
+		[]string{models.AlertFiring, models.AlertResolved, "", "", "", models.AlertFiring})
+	if want := start.Add(50 * time.Minute).UnixMilli(); st.FiredAt != want {
+		t.Errorf("FiredAt = %v, want %v", time.UnixMilli(st.FiredAt), time.UnixMilli(want))
+	}
+}
+
+func TestStepMissingPressure(t *testing.T) {
+	rule := models.AlertRule{ID: 3, Metric: models.MetricPressure, Operator: models.OperatorLT, Value: 1000, ForUpdates: 2}
+	// Замер без давления (0) не прерывает серию и не снимает оповещение
+	runSteps(t, rule, &ruleState{},
+		[]float64{990, 0, 985, 0, 0, 1010},
+		[]string{"", "", models.AlertFiring, "", "", models.AlertResolved})
+}
+
+func TestStepRiseWindow(t *testing.T) {
+	rule := models.AlertRule{ID: 4, Metric: models.MetricHumidity, Operator: models.OperatorRise, Value: 20, Window: 30 * time.Minute}
+	e := &Engine{}
+	st := &ruleState{}
+	var history []sample
+	// В 30 мин рост от 50 — 22, в 40 мин от 55 (50 вышло из окна) — 25, в 50 мин от 65 — только 7
+	values := []float64{50, 55, 65, 72, 80, 72, 72}
+	want := []string{"", "", "", models.AlertFiring, "", models.AlertResolved, ""}
+	for i, v := range values {
+		current := at(10*i, sample{Humidity: v})
+		history = append(history, current) // Engine.history добавляет текущий замер в окно
+		event := e.step(rule, st, current, history, time.UnixMilli(current.At).UTC())
+		got := ""
+		if event != nil {
+			got = event.State
+		}
+		if got != want[i] {
+			t.Errorf("step %d (%v): event %q, want %q", i, v, got, want[i])
+		}
+	}
+}
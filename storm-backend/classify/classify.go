@@ -0,0 +1,167 @@
+package classify
+
+import (
+	"fmt"
+	"math"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Степень опасности ветра (значения совпадают с proto.Severity)
+type Severity int32
+
+const (
+	SeverityNone     Severity = iota // Опасности нет
+	SeverityLow                      // Сильный ветер
+	SeverityModerate                 // Шторм по Бофорту
+	SeverityHigh                     // Сильный шторм или ураган 1–2 категории
+	SeverityExtreme                  // Ураганный ветер или крупный ураган
+)
+
+var severityNames = []string{"none", "low", "moderate", "high", "extreme"}
+
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("severity(%d)", int32(s))
+	}
+	return severityNames[s]
+}
+
+// Названия баллов шкалы Бофорта 0–12
+var beaufortNames = []string{
+	"Calm", "Light air", "Light breeze", "Gentle breeze", "Moderate breeze", "Fresh breeze", "Strong breeze",
+	"Near gale", "Gale", "Strong gale", "Storm", "Violent storm", "Hurricane force",
+}
+
+// Пороги классификации; все скорости в км/ч
+type Thresholds struct {
+	// Нижние границы баллов Бофорта 1–12 по возрастанию
+	Beaufort []float32 `yaml:"beaufort"`
+	// Нижние границы категорий Саффира–Симпсона 1–5 по возрастанию
+	SaffirSimpson []float32 `yaml:"saffir_simpson"`
+	// Тропической считается система не дальше этой широты от экватора; только для неё считается категория
+	TropicalLatitude float32 `yaml:"tropical_latitude"`
+	// Баллы Бофорта, с которых начинается каждая степень опасности, и категория крупного урагана
+	Severity SeverityThresholds `yaml:"severity"`
+}
+
+type SeverityThresholds struct {
+	Low             int `yaml:"low"`
+	Moderate        int `yaml:"moderate"`
+	High            int `yaml:"high"`
+	Extreme         int `yaml:"extreme"`
+	ExtremeCategory int `yaml:"extreme_category"`
+}
+
+// Результат классификации ветра
+type Classification struct {
+	Beaufort    int      // Балл Бофорта 0–12
+	Category    int      // Категория Саффира–Симпсона 1–5, 0 — не ураган или не тропическая система
+	Severity    Severity // Степень опасности по баллу и категории
+	Description string   // Например "Gale" или "Category 3 hurricane"
+}
+
+// Стандартные пороги (ВМО для Бофорта, NHC для Саффира–Симпсона)
+func Default() *Thresholds {
+	return &Thresholds{
+		Beaufort:         []float32{1, 6, 12, 20, 29, 39, 50, 62, 75, 89, 103, 118},
+		SaffirSimpson:    []float32{119, 154, 178, 209, 252},
+		TropicalLatitude: 35,
+		Severity: SeverityThresholds{
+			Low:             6,
+			Moderate:        8,
+			High:            10,
+			Extreme:         12,
+			ExtremeCategory: 3,
+		},
+	}
+}
+
+// Пороги из CLASSIFICATION_FILE (YAML или JSON); без переменной — стандартные.
+// Поля, не указанные в файле, остаются стандартными
+func LoadFromEnv() (*Thresholds, error) {
+	t := Default()
+	path := os.Getenv("CLASSIFICATION_FILE")
+	if path == "" {
+		return t, nil
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read classification thresholds %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(raw, t); err != nil {
+		return nil, fmt.Errorf("failed to parse classification thresholds %s: %w", path, err)
+	}
+	if err := t.validate(); err != nil {
+		return nil, fmt.Errorf("classification thresholds %s: %w", path, err)
+	}
+	return t, nil
+}
+
+func (t *Thresholds) validate() error {
+	if len(t.Beaufort) != 12 {
+		return fmt.Errorf("beaufort must list 12 lower bounds (forces 1-12), got %d", len(t.Beaufort))
+	}
+	if len(t.SaffirSimpson) != 5 {
+		return fmt.Errorf("saffir_simpson must list 5 lower bounds (categories 1-5), got %d", len(t.SaffirSimpson))
+	}
+	if !ascending(t.Beaufort) || !ascending(t.SaffirSimpson) {
+		return fmt.Errorf("thresholds must be strictly ascending")
+	}
+	s := t.Severity
+	if !(0 < s.Low && s.Low <= s.Moderate && s.Moderate <= s.High && s.High <= s.Extreme && s.Extreme <= 12) {
+		return fmt.Errorf("severity forces must satisfy 0 < low <= moderate <= high <= extreme <= 12")
+	}
+	if s.ExtremeCategory < 1 || s.ExtremeCategory > 5 {
+		return fmt.Errorf("severity.extreme_category must be 1-5")
+	}
+	return nil
+}
+
+// Классификация ветра в точке с широтой lat
+func (t *Thresholds) Classify(windKmH float32, lat float32) Classification {
+	c := Classification{Beaufort: rank(t.Beaufort, windKmH)}
+	if float32(math.Abs(float64(lat))) <= t.TropicalLatitude {
+		c.Category = rank(t.SaffirSimpson, windKmH)
+	}
+
+	s := t.Severity
+	switch {
+	case c.Beaufort >= s.Extreme || (c.Category > 0 && c.Category >= s.ExtremeCategory):
+		c.Severity = SeverityExtreme
+	case c.Beaufort >= s.High || c.Category > 0:
+		c.Severity = SeverityHigh
+	case c.Beaufort >= s.Moderate:
+		c.Severity = SeverityModerate
+	case c.Beaufort >= s.Low:
+		c.Severity = SeverityLow
+	}
+
+	c.Description = beaufortNames[c.Beaufort]
+	if c.Category > 0 {
+		c.Description = fmt.Sprintf("Category %d hurricane", c.Category)
+	}
+	return c
+}
+
+// Число порогов, не превышающих значение
+func rank(bounds []float32, value float32) int {
+	n := 0
+	for _, bound := range bounds {
+		if value < bound {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+func ascending(values []float32) bool {
+	for i := 1; i < len(values); i++ {
+		if values[i] <= values[i-1] {
+			return false
+		}
+	}
+	return true
+}
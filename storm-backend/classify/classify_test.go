@@ -0,0 +1,107 @@
+package classify
+
+import "testing"
+
+func TestClassifyBeaufortEdges(t *testing.T) {
+	thresholds := Default()
+	// Вне тропиков категория не считается, поэтому на балл влияет только ветер
+	const lat = 50
+	if c := thresholds.Classify(0, lat); c.Beaufort != 0 || c.Description != "Calm" {
+		t.Errorf("Classify(0) = %+v", c)
+	}
+	for i, bound := range thresholds.Beaufort {
+		force := i + 1
+		if c := thresholds.Classify(bound, lat); c.Beaufort != force || c.Category != 0 || c.Description != beaufortNames[force] {
+			t.Errorf("Classify(%v) = %+v, want force %d", bound, c, force)
+		}
+		if c := thresholds.Classify(bound-0.01, lat); c.Beaufort != force-1 {
+			t.Errorf("Classify(%v) = force %d, want %d", bound-0.01, c.Beaufort, force-1)
+		}
+	}
+	if c := thresholds.Classify(400, lat); c.Beaufort != 12 || c.Category != 0 || c.Description != "Hurricane force" {
+		t.Errorf("Classify(400) = %+v", c)
+	}
+}
+
+func TestClassifySaffirSimpsonEdges(t *testing.T) {
+	thresholds := Default()
+	const lat = 20
+	for i, bound := range thresholds.SaffirSimpson {
+		category := i + 1
+		if c := thresholds.Classify(bound, lat); c.Category != category || c.Beaufort != 12 {
+			t.Errorf("Classify(%v) = %+v, want category %d", bound, c, category)
+		}
+		if c := thresholds.Classify(bound-0.01, lat); c.Category != category-1 {
+			t.Errorf("Classify(%v) = category %d, want %d", bound-0.01, c.Category, category-1)
+		}
+	}
+	// 118 км/ч — уже 12 баллов, но ещё не ураган
+	if c := thresholds.Classify(118, lat); c.Beaufort != 12 || c.Category != 0 || c.Description != "Hurricane force" {
+		t.Errorf("Classify(118) = %+v", c)
+	}
+	if c := thresholds.Classify(119, lat); c.Description != "Category 1 hurricane" {
+		t.Errorf("Classify(119) = %+v", c)
+	}
+}
+
+func TestClassifyTropicalLatitude(t *testing.T) {
+	thresholds := Default()
+	tests := []struct {
+		lat      float32
+		category int
+	}{
+		{0, 4},
+		{34.99, 4},
+		{35, 4}, // Граница включается
+		{35.01, 0},
+		{-35, 4}, // Южное полушарие — по модулю широты
+		{-35.01, 0},
+		{60, 0},
+	}
+	for _, tt := range tests {
+		c := thresholds.Classify(220, tt.lat)
+		if c.Category != tt.category || c.Beaufort != 12 {
+			t.Errorf("Classify(220, %v) = %+v, want category %d", tt.lat, c, tt.category)
+		}
+	}
+}
+
+func TestClassifySeverity(t *testing.T) {
+	thresholds := Default()
+	tests := []struct {
+		wind     float32
+		lat      float32
+		severity Severity
+	}{
+		{38.9, 50, SeverityNone},     // 5 баллов
+		{39, 50, SeverityLow},        // 6 баллов
+		{61.9, 50, SeverityLow},      // 7 баллов
+		{62, 50, SeverityModerate},   // 8 баллов
+		{88.9, 50, SeverityModerate}, // 9 баллов
+		{89, 50, SeverityHigh},       // 10 баллов
+		{117.9, 50, SeverityHigh},    // 11 баллов
+		{118, 50, SeverityExtreme},   // 12 баллов
+		{119, 20, SeverityExtreme},   // Категория 1, но уже 12 баллов
+		{178, 20, SeverityExtreme},   // Категория 3 — крупный ураган
+	}
+	for _, tt := range tests {
+		if c := thresholds.Classify(tt.wind, tt.lat); c.Severity != tt.severity {
+			t.Errorf("Classify(%v, %v) = %s, want %s", tt.wind, tt.lat, c.Severity, tt.severity)
+		}
+	}
+
+	// Ураган поднимает опасность до high, даже если балл до неё не дотягивает
+	custom := Default()
+	custom.Severity.High = 12
+	custom.Beaufort[11] = 130 // 12 баллов только с 130 км/ч
+	if c := custom.Classify(120, 20); c.Beaufort != 11 || c.Category != 1 || c.Severity != SeverityHigh {
+		t.Errorf("category 1 at force 11 = %+v, want high", c)
+	}
+	if c := custom.Classify(120, 50); c.Category != 0 || c.Severity != SeverityModerate {
+		t.Errorf("force 11 outside the tropics = %+v, want moderate", c)
+	}
+	custom.Severity.ExtremeCategory = 1
+	if c := custom.Classify(120, 20); c.Severity != SeverityExtreme {
+		t.Errorf("category 1 with extreme_category 1 = %+v, want extreme", c)
+	}
+}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Столбцы правила в порядке сканирования scanAlertRule
+const alertRuleColumns = `id, user_id, name, region, metric, operator, value, for_updates, window_seconds, cooldown_seconds, enabled, created_at`
+
+func (s *sqlStore) CreateAlertRule(ctx context.Context, rule *models.AlertRule) error {
+	id, err := s.insertID(ctx, `INSERT INTO alert_rules
+        (user_id, name, region, metric, operator, value, for_updates, window_seconds, cooldown_seconds, enabled, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rule.UserID, rule.Name, rule.Region, rule.Metric, rule.Operator, rule.Value, rule.ForUpdates,
+		int(rule.Window/time.Second), int(rule.Cooldown/time.Second), rule.Enabled, rule.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create alert rule: %w", err)
+	}
+	rule.ID = id
+	return nil
+}
+
+func (s *sqlStore) ListAlertRules(ctx context.Context, userID string) ([]models.AlertRule, error) {
+	return s.queryAlertRules(ctx, `SELECT `+alertRuleColumns+` FROM alert_rules WHERE user_id = ? ORDER BY id`, userID)
+}
+
+func (s *sqlStore) RegionAlertRules(ctx context.Context, region string) ([]models.AlertRule, error) {
+	return s.queryAlertRules(ctx, `SELECT `+alertRuleColumns+` FROM alert_rules WHERE region = ? AND enabled = ? ORDER BY id`, region, true)
+}
+
+func (s *sqlStore) DeleteAlertRule(ctx context.Context, userID string, id int64) error {
+	res, err := s.db.ExecContext(ctx, s.dialect.bind(`DELETE FROM alert_rules WHERE id = ? AND user_id = ?`), id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete alert rule %d: %w", id, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlStore) queryAlertRules(ctx context.Context, query string, args ...interface{}) ([]models.AlertRule, error) {
+	rows, err := s.db.QueryContext(ctx, s.dialect.bind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []models.AlertRule
+	for rows.Next() {
+		var (
+			rule             models.AlertRule
+			window, cooldown int
+		)
+		err := rows.Scan(&rule.ID, &rule.UserID, &rule.Name, &rule.Region, &rule.Metric, &rule.Operator, &rule.Value,
+			&rule.ForUpdates, &window, &cooldown, &rule.Enabled, &rule.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read alert rule: %w", err)
+		}
+		rule.Window = time.Duration(window) * time.Second
+		rule.Cooldown = time.Duration(cooldown) * time.Second
+		rule.CreatedAt = rule.CreatedAt.UTC()
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list alert rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (s *sqlStore) AddAlertEvent(ctx context.Context, event *models.AlertEvent) error {
+	id, err := s.insertID(ctx, `INSERT INTO alert_events
+        (rule_id, user_id, rule_name, region, state, value, message, sequence, occurred_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		event.RuleID, event.UserID, event.RuleName, event.Region, event.State, event.Value, event.Message,
+		int64(event.Sequence), event.OccurredAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to save alert event of rule %d: %w", event.RuleID, err)
+	}
+	event.ID = id
+	return nil
+}
+
+func (s *sqlStore) ListAlertEvents(ctx context.Context, userID string, limit int) ([]models.AlertEvent, error) {
+	rows, err := s.db.QueryContext(ctx, s.dialect.bind(`SELECT id, rule_id, user_id, rule_name, region, state, value, message, sequence, occurred_at
+        FROM alert_events WHERE user_id = ? ORDER BY occurred_at DESC, id DESC LIMIT ?`), userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.AlertEvent
+	for rows.Next() {
+		var (
+			event    models.AlertEvent
+			sequence int64
+		)
+		err := rows.Scan(&event.ID, &event.RuleID, &event.UserID, &event.RuleName, &event.Region, &event.State,
+			&event.Value, &event.Message, &sequence, &event.OccurredAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read alert event: %w", err)
+		}
+		event.Sequence = uint64(sequence)
+		event.OccurredAt = event.OccurredAt.UTC()
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list alert events: %w", err)
+	}
+	return events, nil
+}
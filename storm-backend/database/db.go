@@ -1,83 +1,55 @@
 package database
 
 import (
-	"database/sql"
+	"context"
 	"fmt"
 	"os"
 
-	_ "github.com/go-sql-driver/mysql"
 	"github.com/rs/zerolog/log"
 )
 
-var DB *sql.DB
+var DB Store
 
+// Подключение к базе и применение миграций (если не отключено через DB_AUTO_MIGRATE=false)
 func InitDB() error {
-	user := os.Getenv("MYSQL_USER")
-	password := os.Getenv("MYSQL_PASSWORD")
-	host := os.Getenv("MYSQL_HOST")
-	port := os.Getenv("MYSQL_PORT")
-	dbname := os.Getenv("MYSQL_DBNAME")
-
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true",
-		user, password, host, port, dbname)
-
-	var err error
-	DB, err = sql.Open("mysql", dsn) // Подключаемся к MySQL, для которого импортировали драйвер
-	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to connect to MySQL")
+	if err := Connect(); err != nil {
+		return err
 	}
-
-	if err = DB.Ping(); err != nil {
-		log.Fatal().Err(err).Msg("MySQL ping failed") // Проверка соединения
+	if os.Getenv("DB_AUTO_MIGRATE") == "false" { // Схемой управляют отдельно через storm-backend migrate
+		return nil
 	}
 
-	log.Info().Msg("[info] Successfully connected to MySQL")
-
-	createTable := `
-    CREATE TABLE IF NOT EXISTS storms (
-        id INT AUTO_INCREMENT PRIMARY KEY,
-        region VARCHAR(100) NOT NULL,
-        latitude FLOAT NOT NULL,
-        longitude FLOAT NOT NULL,
-        wind_speed INT NOT NULL,
-        timestamp TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-        UNIQUE KEY unique_region_timestamp (region, timestamp)
-    );
-    `
-	_, err = DB.Exec(createTable)
+	ctx := context.Background()
+	migrator, err := DB.Migrator()
 	if err != nil {
-		log.Fatal().Err(err).Msg("Error to create table")
-		return err
+		return fmt.Errorf("failed to load migrations: %w", err)
 	}
-
-	var count int
-	err = DB.QueryRow(`SELECT COUNT(*) FROM storms`).Scan(&count)
+	applied, err := migrator.Up(ctx)
 	if err != nil {
-		log.Error().Err(err).Msg("[error] Failed to count storm-notes")
-		return fmt.Errorf("failed to count storm-notes: %w", err)
+		return fmt.Errorf("failed to migrate database: %w", err)
 	}
+	log.Info().Int("applied", applied).Str("dialect", DB.Dialect()).Msg("Database schema is up to date")
 
-	insertData := `
-    INSERT IGNORE INTO storms (region, latitude, longitude, wind_speed) VALUES
-    ('Atlantic', 25.3, -75.2, 140),
-    ('Pacific', 15.4, -120.5, 130);
-    `
-
-	if count == 0 {
-		_, err = DB.Exec(insertData)
-		if err != nil {
-			log.Fatal().Err(err).Msg("Error to insert data")
-			return err
+	if os.Getenv("DB_SEED") == "true" { // Демонстрационные данные только по явному запросу
+		if err := DB.Seed(ctx); err != nil {
+			return fmt.Errorf("failed to seed database: %w", err)
 		}
 	}
+	return nil
+}
 
-	log.Info().Msg("Database initialized and seeded")
-
+// Только подключение к базе из DATABASE_URL (или MYSQL_*), без миграций
+func Connect() error {
+	store, err := Open(DSNFromEnv())
+	if err != nil {
+		return err
+	}
+	DB = store
 	return nil
 }
 
 // Получение глобальной переменной DB
-func GetDB() *sql.DB {
+func GetDB() Store {
 	return DB
 }
 
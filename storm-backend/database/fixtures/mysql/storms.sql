@@ -0,0 +1,5 @@
+-- Демонстрационные данные; повторный запуск ничего не дублирует
+INSERT IGNORE INTO storms
+    (storm_id, region, status, latitude, longitude, wind_speed, timestamp, started_at, ended_at, updated_at, peak_wind_kmh, peak_at) VALUES
+('demo-atlantic', 'Atlantic', 'closed', 25.3, -75.2, 140, '2025-01-01 00:00:00', '2025-01-01 00:00:00', '2025-01-01 06:00:00', '2025-01-01 06:00:00', 140, '2025-01-01 00:00:00'),
+('demo-pacific', 'Pacific', 'closed', 15.4, -120.5, 130, '2025-01-01 00:00:00', '2025-01-01 00:00:00', '2025-01-01 06:00:00', '2025-01-01 06:00:00', 130, '2025-01-01 00:00:00');
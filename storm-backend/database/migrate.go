@@ -0,0 +1,348 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Миграции и фикстуры по каталогам диалектов: migrations/mysql, migrations/postgres и т.д.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+//go:embed fixtures
+var fixtureFiles embed.FS
+
+// Версионированная миграция схемы из пары файлов NNNN_name.up.sql / NNNN_name.down.sql
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Состояние миграции для migrate status
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time // nil — миграция не применена
+}
+
+// Применение миграций одной базы; одновременно мигрировать может только одна реплика
+type Migrator struct {
+	db         *sql.DB
+	dialect    *dialect
+	migrations []Migration
+}
+
+func newMigrator(db *sql.DB, d *dialect) (*Migrator, error) {
+	migrations, err := loadMigrations(migrationFiles, d.migrations)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: d, migrations: migrations}, nil
+}
+
+// Применение всех ещё не применённых миграций по возрастанию версии
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := done[mig.Version]; ok {
+				continue
+			}
+			err := m.apply(ctx, conn, mig.Up, func(db execer) error {
+				if _, err := db.ExecContext(ctx, m.dialect.bind(`INSERT INTO schema_migrations (version, name) VALUES (?, ?)`), mig.Version, mig.Name); err != nil {
+					return fmt.Errorf("failed to record migration %04d: %w", mig.Version, err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("migration %04d_%s up: %w", mig.Version, mig.Name, err)
+			}
+			log.Info().Int64("version", mig.Version).Str("name", mig.Name).Msg("Migration applied")
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Откат последних steps применённых миграций
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	reverted := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := done[mig.Version]; !ok {
+				continue
+			}
+			err := m.apply(ctx, conn, mig.Down, func(db execer) error {
+				if _, err := db.ExecContext(ctx, m.dialect.bind(`DELETE FROM schema_migrations WHERE version = ?`), mig.Version); err != nil {
+					return fmt.Errorf("failed to unrecord migration %04d: %w", mig.Version, err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("migration %04d_%s down: %w", mig.Version, mig.Name, err)
+			}
+			log.Info().Int64("version", mig.Version).Str("name", mig.Name).Msg("Migration reverted")
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Выполнение скрипта миграции и отметки о ней в schema_migrations.
+// В PostgreSQL оба шага идут в одной транзакции: упавшая миграция не оставляет
+// половину схемы. MySQL фиксирует каждую DDL неявно, поэтому там ошибка в середине
+// скрипта оставляет выполненные инструкции; такую миграцию нужно доделать вручную
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, script string, record func(db execer) error) error {
+	if !m.dialect.transactionalDDL {
+		if err := execScript(ctx, conn, script); err != nil {
+			return err
+		}
+		return record(conn)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // После Commit ничего не делает
+	if err := execScript(ctx, tx, script); err != nil {
+		return err
+	}
+	if err := record(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Список всех известных миграций с отметкой о применении
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	if _, err := m.db.ExecContext(ctx, m.dialect.createMigrationsTable); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	done, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		st := MigrationStatus{Migration: mig}
+		if at, ok := done[mig.Version]; ok {
+			st.AppliedAt = &at
+		}
+		statuses = append(statuses, st)
+	}
+	return statuses, nil
+}
+
+// Загрузка демонстрационных данных; отдельный шаг, не входящий в миграции схемы
+func seed(ctx context.Context, db *sql.DB, d *dialect) error {
+	names, err := fs.Glob(fixtureFiles, d.fixtures+"/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	for _, name := range names {
+		script, err := fixtureFiles.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if err := execScript(ctx, conn, string(script)); err != nil {
+			return fmt.Errorf("fixture %s: %w", path.Base(name), err)
+		}
+		log.Info().Str("fixture", path.Base(name)).Msg("Fixture loaded")
+	}
+	return nil
+}
+
+// Выполнение fn под блокировкой миграций. Блокировка привязана к соединению,
+// поэтому все запросы идут через одно *sql.Conn
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if err := m.dialect.lock(ctx, conn); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		if err := m.dialect.unlock(context.Background(), conn); err != nil {
+			log.Error().Err(err).Msg("Failed to release migration lock")
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, m.dialect.createMigrationsTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	done := make(map[int64]time.Time)
+	for rows.Next() {
+		var version int64
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, err
+		}
+		done[version] = at
+	}
+	return done, rows.Err()
+}
+
+// Разбор файлов миграций; у каждой версии должны быть ровно один up и ровно один down
+func loadMigrations(files fs.FS, dir string) ([]Migration, error) {
+	names, err := fs.Glob(files, dir+"/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	seen := make(map[string]string) // "версия.направление" -> файл
+	for _, name := range names {
+		base := path.Base(name)
+		stem, direction, ok := strings.Cut(strings.TrimSuffix(base, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("migration %s: expected NNNN_name.up.sql or NNNN_name.down.sql", base)
+		}
+		rawVersion, title, _ := strings.Cut(stem, "_")
+		version, err := strconv.ParseInt(rawVersion, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: invalid version: %w", base, err)
+		}
+		key := strconv.FormatInt(version, 10) + "." + direction
+		if other, ok := seen[key]; ok {
+			return nil, fmt.Errorf("migration %s duplicates %s", base, other)
+		}
+		seen[key] = base
+		script, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+
+		mig := byVersion[version]
+		if mig == nil {
+			mig = &Migration{Version: version, Name: title}
+			byVersion[version] = mig
+		} else if mig.Name != title {
+			return nil, fmt.Errorf("migration %04d has conflicting names %q and %q", version, mig.Name, title)
+		}
+		if direction == "up" {
+			mig.Up = string(script)
+		} else {
+			mig.Down = string(script)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" || mig.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s must have both up and down files", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Выполнение SQL-скрипта по одной инструкции: драйвер MySQL не включает multiStatements
+func execScript(ctx context.Context, db execer, script string) error {
+	for _, stmt := range splitScript(script) {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Разбиение скрипта на инструкции. Инструкция заканчивается точкой с запятой в конце строки;
+// внутри строковых литералов '...' и блоков $$ ... $$ точка с запятой инструкцию не завершает
+func splitScript(script string) []string {
+	var (
+		stmts    []string
+		stmt     strings.Builder
+		inString bool
+		inBlock  bool
+	)
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !inString && !inBlock && (trimmed == "" || strings.HasPrefix(trimmed, "--")) {
+			continue
+		}
+		stmt.WriteString(line)
+		stmt.WriteString("\n")
+
+	scan:
+		for i := 0; i < len(line); i++ {
+			switch {
+			case inBlock:
+				if strings.HasPrefix(line[i:], "$$") {
+					inBlock = false
+					i++
+				}
+			case inString:
+				if line[i] == '\'' { // Удвоенная кавычка '' закрывает и снова открывает литерал
+					inString = false
+				}
+			case line[i] == '\'':
+				inString = true
+			case strings.HasPrefix(line[i:], "$$"):
+				inBlock = true
+				i++
+			case strings.HasPrefix(line[i:], "--"):
+				break scan
+			}
+		}
+
+		if !inString && !inBlock && strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, stmt.String())
+			stmt.Reset()
+		}
+	}
+	if strings.TrimSpace(stmt.String()) != "" {
+		stmts = append(stmts, stmt.String())
+	}
+	return stmts
+}
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	files := fstest.MapFS{
+		"m/0010_add_index.up.sql":     {Data: []byte("CREATE INDEX i ON t (a);")},
+		"m/0010_add_index.down.sql":   {Data: []byte("DROP INDEX i;")},
+		"m/0002_create_t.up.sql":      {Data: []byte("CREATE TABLE t (a INT);")},
+		"m/0002_create_t.down.sql":    {Data: []byte("DROP TABLE t;")},
+		"m/0009_rename.up.sql":        {Data: []byte("ALTER TABLE t RENAME a TO b;")},
+		"m/0009_rename.down.sql":      {Data: []byte("ALTER TABLE t RENAME b TO a;")},
+		"m/README.md":                 {Data: []byte("not a migration")},
+		"other/0001_elsewhere.up.sql": {Data: []byte("SELECT 1;")},
+	}
+	migrations, err := loadMigrations(files, "m")
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+	// Порядок по числу версии, а не по имени файла и не по порядку обхода
+	var got []string
+	for _, mig := range migrations {
+		got = append(got, mig.Name)
+	}
+	if want := []string{"create_t", "rename", "add_index"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("migrations = %v, want %v", got, want)
+	}
+	if m := migrations[0]; m.Version != 2 || m.Up != "CREATE TABLE t (a INT);" || m.Down != "DROP TABLE t;" {
+		t.Errorf("first migration = %+v", m)
+	}
+
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+		want  string
+	}{
+		{"same version twice", fstest.MapFS{
+			"m/0003_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"m/0003_a.down.sql": {Data: []byte("SELECT 1;")},
+			"m/003_a.up.sql":    {Data: []byte("SELECT 2;")},
+		}, "duplicates"},
+		{"conflicting names", fstest.MapFS{
+			"m/0003_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"m/0003_b.down.sql": {Data: []byte("SELECT 1;")},
+		}, `conflicting names "a" and "b"`},
+		{"missing down", fstest.MapFS{
+			"m/0003_a.up.sql": {Data: []byte("SELECT 1;")},
+		}, "must have both up and down"},
+		{"bad direction", fstest.MapFS{
+			"m/0003_a.sideways.sql": {Data: []byte("SELECT 1;")},
+		}, "expected NNNN_name.up.sql"},
+		{"bad version", fstest.MapFS{
+			"m/first_a.up.sql": {Data: []byte("SELECT 1;")},
+		}, "invalid version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadMigrations(tt.files, "m")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	// У обоих диалектов одинаковый набор версий
+	mysql, err := loadMigrations(migrationFiles, mysqlDialect.migrations)
+	if err != nil {
+		t.Fatalf("mysql: %v", err)
+	}
+	postgres, err := loadMigrations(migrationFiles, postgresDialect.migrations)
+	if err != nil {
+		t.Fatalf("postgres: %v", err)
+	}
+	if len(mysql) != len(postgres) {
+		t.Fatalf("mysql has %d migrations, postgres %d", len(mysql), len(postgres))
+	}
+	for i := range mysql {
+		if mysql[i].Version != postgres[i].Version || mysql[i].Name != postgres[i].Name {
+			t.Errorf("migration %d: mysql %04d_%s, postgres %04d_%s", i, mysql[i].Version, mysql[i].Name, postgres[i].Version, postgres[i].Name)
+		}
+	}
+}
+
+func TestSplitScript(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{"one statement per line", "CREATE TABLE a (x INT);\nCREATE TABLE b (y INT);\n",
+			[]string{"CREATE TABLE a (x INT);\n", "CREATE TABLE b (y INT);\n"}},
+		{"statement over several lines", "CREATE TABLE a (\n    x INT\n);\n",
+			[]string{"CREATE TABLE a (\n    x INT\n);\n"}},
+		{"comments and blank lines are skipped", "-- header\n\nSELECT 1;\n  -- trailing\n",
+			[]string{"SELECT 1;\n"}},
+		{"last statement without a semicolon", "SELECT 1;\nSELECT 2\n",
+			[]string{"SELECT 1;\n", "SELECT 2\n"}},
+		{"semicolon in the middle of a line", "SELECT 1; SELECT 2\n;\n",
+			[]string{"SELECT 1; SELECT 2\n;\n"}},
+		{"dollar-quoted body", "DO $$\nBEGIN\n    PERFORM 1;\n    PERFORM 2;\nEND\n$$;\nSELECT 3;\n",
+			[]string{"DO $$\nBEGIN\n    PERFORM 1;\n    PERFORM 2;\nEND\n$$;\n", "SELECT 3;\n"}},
+		{"dollar quotes on one line", "CREATE FUNCTION f() RETURNS INT AS $$ SELECT 1; $$ LANGUAGE sql;\nSELECT f();\n",
+			[]string{"CREATE FUNCTION f() RETURNS INT AS $$ SELECT 1; $$ LANGUAGE sql;\n", "SELECT f();\n"}},
+		{"semicolon at the end of a line inside a literal", "INSERT INTO t VALUES ('a;\nb');\nSELECT 1;\n",
+			[]string{"INSERT INTO t VALUES ('a;\nb');\n", "SELECT 1;\n"}},
+		{"doubled quote inside a literal", "INSERT INTO t VALUES ('it''s;\n-- not a comment\n');\n",
+			[]string{"INSERT INTO t VALUES ('it''s;\n-- not a comment\n');\n"}},
+		{"quote in a comment", "SELECT 1; -- don't split here\nSELECT 2;\n",
+			[]string{"SELECT 1; -- don't split here\nSELECT 2;\n"}},
+		{"dollar quotes in a literal", "INSERT INTO t VALUES ('$$;');\nSELECT 1;\n",
+			[]string{"INSERT INTO t VALUES ('$$;');\n", "SELECT 1;\n"}},
+		{"empty script", "\n-- nothing\n", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitScript(tt.script); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitScript = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
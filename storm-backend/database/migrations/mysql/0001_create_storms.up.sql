@@ -0,0 +1,10 @@
+-- Базовая схема: совпадает с таблицей, которую раньше создавал InitDB, поэтому IF NOT EXISTS
+CREATE TABLE IF NOT EXISTS storms (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    region VARCHAR(100) NOT NULL,
+    latitude FLOAT NOT NULL,
+    longitude FLOAT NOT NULL,
+    wind_speed INT NOT NULL,
+    timestamp TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY unique_region_timestamp (region, timestamp)
+);
@@ -0,0 +1,18 @@
+-- Временной ряд замеров от weather-worker
+CREATE TABLE IF NOT EXISTS observations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    region VARCHAR(100) NOT NULL,
+    point VARCHAR(100) NOT NULL,
+    latitude FLOAT NOT NULL,
+    longitude FLOAT NOT NULL,
+    temp FLOAT NOT NULL,
+    humidity INT NOT NULL,
+    wind_kmh FLOAT NOT NULL,
+    pressure FLOAT NULL,
+    provider VARCHAR(50) NOT NULL,
+    observed_at DATETIME(3) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY unique_observation (region, point, provider, observed_at),
+    KEY idx_observations_region_time (region, observed_at),
+    KEY idx_observations_time (observed_at)
+);
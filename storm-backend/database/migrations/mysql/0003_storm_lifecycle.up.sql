@@ -0,0 +1,39 @@
+-- Жизненный цикл шторма. latitude/longitude/wind_speed теперь хранят последнее положение и ветер
+ALTER TABLE storms
+    ADD COLUMN storm_id VARCHAR(64) NULL,
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'closed',
+    ADD COLUMN started_at DATETIME(3) NULL,
+    ADD COLUMN ended_at DATETIME(3) NULL,
+    ADD COLUMN updated_at DATETIME(3) NULL,
+    ADD COLUMN peak_wind_kmh FLOAT NULL,
+    ADD COLUMN peak_at DATETIME(3) NULL,
+    ADD COLUMN min_pressure FLOAT NULL;
+
+-- Старые записи становятся закрытыми штормами с идентификатором legacy-<id>
+UPDATE storms SET
+    storm_id = CONCAT('legacy-', id),
+    started_at = timestamp,
+    ended_at = timestamp,
+    updated_at = timestamp,
+    peak_wind_kmh = wind_speed,
+    peak_at = timestamp
+WHERE storm_id IS NULL;
+
+ALTER TABLE storms
+    MODIFY storm_id VARCHAR(64) NOT NULL,
+    MODIFY started_at DATETIME(3) NOT NULL,
+    ADD UNIQUE KEY unique_storm_id (storm_id),
+    ADD KEY idx_storms_region_status (region, status);
+
+CREATE TABLE IF NOT EXISTS storm_track_points (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    storm_id VARCHAR(64) NOT NULL,
+    observed_at DATETIME(3) NOT NULL,
+    point VARCHAR(100) NOT NULL,
+    latitude FLOAT NOT NULL,
+    longitude FLOAT NOT NULL,
+    wind_kmh FLOAT NOT NULL,
+    pressure FLOAT NULL,
+    UNIQUE KEY unique_track_point (storm_id, observed_at, point),
+    KEY idx_track_storm_time (storm_id, observed_at)
+);
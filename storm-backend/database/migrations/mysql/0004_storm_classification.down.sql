@@ -0,0 +1,5 @@
+ALTER TABLE storms
+    DROP KEY idx_storms_category,
+    DROP COLUMN peak_beaufort,
+    DROP COLUMN peak_category,
+    DROP COLUMN severity;
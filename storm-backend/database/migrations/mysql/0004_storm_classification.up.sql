@@ -0,0 +1,28 @@
+-- Классификация пика шторма: балл Бофорта, категория Саффира–Симпсона и степень опасности
+ALTER TABLE storms
+    ADD COLUMN peak_beaufort TINYINT NOT NULL DEFAULT 0,
+    ADD COLUMN peak_category TINYINT NOT NULL DEFAULT 0,
+    ADD COLUMN severity TINYINT NOT NULL DEFAULT 0;
+
+-- Существующие штормы классифицируются по стандартным порогам; новые — по текущей настройке
+UPDATE storms SET
+    peak_beaufort = CASE
+        WHEN peak_wind_kmh >= 118 THEN 12 WHEN peak_wind_kmh >= 103 THEN 11 WHEN peak_wind_kmh >= 89 THEN 10
+        WHEN peak_wind_kmh >= 75 THEN 9 WHEN peak_wind_kmh >= 62 THEN 8 WHEN peak_wind_kmh >= 50 THEN 7
+        WHEN peak_wind_kmh >= 39 THEN 6 WHEN peak_wind_kmh >= 29 THEN 5 WHEN peak_wind_kmh >= 20 THEN 4
+        WHEN peak_wind_kmh >= 12 THEN 3 WHEN peak_wind_kmh >= 6 THEN 2 WHEN peak_wind_kmh >= 1 THEN 1
+        ELSE 0 END,
+    peak_category = CASE
+        WHEN ABS(latitude) > 35 THEN 0
+        WHEN peak_wind_kmh >= 252 THEN 5 WHEN peak_wind_kmh >= 209 THEN 4 WHEN peak_wind_kmh >= 178 THEN 3
+        WHEN peak_wind_kmh >= 154 THEN 2 WHEN peak_wind_kmh >= 119 THEN 1
+        ELSE 0 END;
+
+UPDATE storms SET severity = CASE
+    WHEN peak_beaufort >= 12 OR peak_category >= 3 THEN 4
+    WHEN peak_beaufort >= 10 OR peak_category > 0 THEN 3
+    WHEN peak_beaufort >= 8 THEN 2
+    WHEN peak_beaufort >= 6 THEN 1
+    ELSE 0 END;
+
+CREATE INDEX idx_storms_category ON storms (peak_category);
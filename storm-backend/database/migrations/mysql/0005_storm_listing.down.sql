@@ -0,0 +1 @@
+DROP INDEX idx_storms_started_at ON storms;
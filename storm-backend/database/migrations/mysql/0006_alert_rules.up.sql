@@ -0,0 +1,33 @@
+-- Правила оповещений пользователей (владелец — subject токена Keycloak)
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    region VARCHAR(100) NOT NULL,
+    metric VARCHAR(16) NOT NULL,
+    operator VARCHAR(8) NOT NULL,
+    value DOUBLE NOT NULL,
+    for_updates INT NOT NULL DEFAULT 1,
+    window_seconds INT NOT NULL DEFAULT 0,
+    cooldown_seconds INT NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at DATETIME(3) NOT NULL,
+    KEY idx_alert_rules_user (user_id),
+    KEY idx_alert_rules_region (region, enabled)
+);
+
+-- Срабатывания и снятия оповещений
+CREATE TABLE IF NOT EXISTS alert_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    rule_id BIGINT NOT NULL,
+    user_id VARCHAR(64) NOT NULL,
+    rule_name VARCHAR(200) NOT NULL,
+    region VARCHAR(100) NOT NULL,
+    state VARCHAR(16) NOT NULL,
+    value DOUBLE NOT NULL,
+    message VARCHAR(500) NOT NULL,
+    sequence BIGINT NOT NULL DEFAULT 0,
+    occurred_at DATETIME(3) NOT NULL,
+    KEY idx_alert_events_user_time (user_id, occurred_at),
+    KEY idx_alert_events_rule (rule_id)
+);
@@ -0,0 +1,13 @@
+-- Адреса исходящих уведомлений пользователей; secret подписывает тело запроса (HMAC-SHA256)
+CREATE TABLE IF NOT EXISTS webhooks (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    notify_alerts BOOLEAN NOT NULL DEFAULT TRUE,
+    notify_storms BOOLEAN NOT NULL DEFAULT FALSE,
+    region VARCHAR(100) NOT NULL DEFAULT '',
+    created_at DATETIME(3) NOT NULL,
+    KEY idx_webhooks_user (user_id),
+    KEY idx_webhooks_storms (notify_storms, region)
+);
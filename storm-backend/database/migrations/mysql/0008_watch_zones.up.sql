@@ -0,0 +1,22 @@
+-- Зоны наблюдения пользователей: круг (center_*, radius_km) или многоугольник (polygon — JSON [{"lat","lon"}]).
+-- min_*/max_* — описанный прямоугольник, по которому выбираются зоны-кандидаты для точки
+CREATE TABLE IF NOT EXISTS watch_zones (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    shape VARCHAR(16) NOT NULL,
+    center_lat DOUBLE NOT NULL DEFAULT 0,
+    center_lon DOUBLE NOT NULL DEFAULT 0,
+    radius_km DOUBLE NOT NULL DEFAULT 0,
+    polygon TEXT,
+    min_wind_kmh FLOAT NOT NULL DEFAULT 0,
+    min_lat DOUBLE NOT NULL,
+    max_lat DOUBLE NOT NULL,
+    min_lon DOUBLE NOT NULL,
+    max_lon DOUBLE NOT NULL,
+    created_at DATETIME(3) NOT NULL,
+    KEY idx_watch_zones_user (user_id),
+    KEY idx_watch_zones_lat (min_lat, max_lat)
+);
+
+ALTER TABLE webhooks ADD COLUMN notify_zones BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,12 @@
+DELETE FROM storm_track_points WHERE storm_id IN (SELECT storm_id FROM storms WHERE source <> 'live');
+DELETE FROM storms WHERE source <> 'live';
+ALTER TABLE storm_track_points
+    DROP COLUMN record_id,
+    DROP COLUMN system_status,
+    DROP COLUMN wind_radii;
+ALTER TABLE storms
+    DROP KEY idx_storms_source,
+    DROP COLUMN name,
+    DROP COLUMN source,
+    MODIFY timestamp TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD UNIQUE KEY unique_region_timestamp (region, timestamp);
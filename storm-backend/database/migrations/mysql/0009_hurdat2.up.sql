@@ -0,0 +1,15 @@
+-- Исторические штормы из HURDAT2 рядом с обнаруженными детектором. Старый столбец timestamp
+-- (TIMESTAMP, только с 1970 года) и уникальность (region, timestamp) мешают архиву: штормы XIX века
+-- и два шторма бассейна с одним сроком начала. Идентификатор шторма теперь storm_id
+ALTER TABLE storms
+    DROP KEY unique_region_timestamp,
+    MODIFY timestamp DATETIME(3) NULL,
+    ADD COLUMN name VARCHAR(64) NULL,
+    ADD COLUMN source VARCHAR(16) NOT NULL DEFAULT 'live',
+    ADD KEY idx_storms_source (source);
+
+-- Поля точек best track: идентификатор записи (L — выход на сушу и т.д.), статус системы и радиусы ветра (JSON)
+ALTER TABLE storm_track_points
+    ADD COLUMN record_id VARCHAR(1) NULL,
+    ADD COLUMN system_status VARCHAR(2) NULL,
+    ADD COLUMN wind_radii TEXT NULL;
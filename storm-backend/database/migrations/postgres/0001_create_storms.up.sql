@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS storms (
+    id SERIAL PRIMARY KEY,
+    region VARCHAR(100) NOT NULL,
+    latitude REAL NOT NULL,
+    longitude REAL NOT NULL,
+    wind_speed INT NOT NULL,
+    timestamp TIMESTAMPTZ DEFAULT now(),
+    CONSTRAINT unique_region_timestamp UNIQUE (region, timestamp)
+);
@@ -0,0 +1,28 @@
+-- Временной ряд замеров от weather-worker. Первичный ключ включает observed_at,
+-- чтобы таблицу можно было сделать гипертаблицей TimescaleDB
+CREATE TABLE IF NOT EXISTS observations (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    region VARCHAR(100) NOT NULL,
+    point VARCHAR(100) NOT NULL,
+    latitude REAL NOT NULL,
+    longitude REAL NOT NULL,
+    temp REAL NOT NULL,
+    humidity INT NOT NULL,
+    wind_kmh REAL NOT NULL,
+    pressure REAL NULL,
+    provider VARCHAR(50) NOT NULL,
+    observed_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    PRIMARY KEY (observed_at, id),
+    CONSTRAINT unique_observation UNIQUE (region, point, provider, observed_at)
+);
+CREATE INDEX IF NOT EXISTS idx_observations_region_time ON observations (region, observed_at);
+
+-- Если расширение TimescaleDB установлено, таблица становится гипертаблицей
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'timescaledb') THEN
+        PERFORM create_hypertable('observations', 'observed_at', if_not_exists => TRUE);
+    END IF;
+END
+$$;
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS storm_track_points;
+
+DROP INDEX IF EXISTS idx_storms_region_status;
+ALTER TABLE storms
+    DROP CONSTRAINT IF EXISTS unique_storm_id,
+    DROP COLUMN storm_id,
+    DROP COLUMN status,
+    DROP COLUMN started_at,
+    DROP COLUMN ended_at,
+    DROP COLUMN updated_at,
+    DROP COLUMN peak_wind_kmh,
+    DROP COLUMN peak_at,
+    DROP COLUMN min_pressure;
@@ -0,0 +1,39 @@
+-- Жизненный цикл шторма. latitude/longitude/wind_speed теперь хранят последнее положение и ветер
+ALTER TABLE storms
+    ADD COLUMN storm_id VARCHAR(64) NULL,
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'closed',
+    ADD COLUMN started_at TIMESTAMPTZ NULL,
+    ADD COLUMN ended_at TIMESTAMPTZ NULL,
+    ADD COLUMN updated_at TIMESTAMPTZ NULL,
+    ADD COLUMN peak_wind_kmh REAL NULL,
+    ADD COLUMN peak_at TIMESTAMPTZ NULL,
+    ADD COLUMN min_pressure REAL NULL;
+
+-- Старые записи становятся закрытыми штормами с идентификатором legacy-<id>
+UPDATE storms SET
+    storm_id = 'legacy-' || id,
+    started_at = timestamp,
+    ended_at = timestamp,
+    updated_at = timestamp,
+    peak_wind_kmh = wind_speed,
+    peak_at = timestamp
+WHERE storm_id IS NULL;
+
+ALTER TABLE storms
+    ALTER COLUMN storm_id SET NOT NULL,
+    ALTER COLUMN started_at SET NOT NULL,
+    ADD CONSTRAINT unique_storm_id UNIQUE (storm_id);
+CREATE INDEX IF NOT EXISTS idx_storms_region_status ON storms (region, status);
+
+CREATE TABLE IF NOT EXISTS storm_track_points (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    storm_id VARCHAR(64) NOT NULL,
+    observed_at TIMESTAMPTZ NOT NULL,
+    point VARCHAR(100) NOT NULL,
+    latitude REAL NOT NULL,
+    longitude REAL NOT NULL,
+    wind_kmh REAL NOT NULL,
+    pressure REAL NULL,
+    CONSTRAINT unique_track_point UNIQUE (storm_id, observed_at, point)
+);
+CREATE INDEX IF NOT EXISTS idx_track_storm_time ON storm_track_points (storm_id, observed_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_storms_category;
+ALTER TABLE storms
+    DROP COLUMN peak_beaufort,
+    DROP COLUMN peak_category,
+    DROP COLUMN severity;
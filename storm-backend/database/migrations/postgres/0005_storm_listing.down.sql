@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_storms_started_at;
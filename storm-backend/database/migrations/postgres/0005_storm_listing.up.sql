@@ -0,0 +1,2 @@
+-- Выборка штормов по временному окну, сначала самые поздние
+CREATE INDEX IF NOT EXISTS idx_storms_started_at ON storms (started_at);
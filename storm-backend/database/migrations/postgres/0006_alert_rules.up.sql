@@ -0,0 +1,33 @@
+-- Правила оповещений пользователей (владелец — subject токена Keycloak)
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    region VARCHAR(100) NOT NULL,
+    metric VARCHAR(16) NOT NULL,
+    operator VARCHAR(8) NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    for_updates INT NOT NULL DEFAULT 1,
+    window_seconds INT NOT NULL DEFAULT 0,
+    cooldown_seconds INT NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_alert_rules_user ON alert_rules (user_id);
+CREATE INDEX IF NOT EXISTS idx_alert_rules_region ON alert_rules (region, enabled);
+
+-- Срабатывания и снятия оповещений
+CREATE TABLE IF NOT EXISTS alert_events (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    rule_id BIGINT NOT NULL,
+    user_id VARCHAR(64) NOT NULL,
+    rule_name VARCHAR(200) NOT NULL,
+    region VARCHAR(100) NOT NULL,
+    state VARCHAR(16) NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    message VARCHAR(500) NOT NULL,
+    sequence BIGINT NOT NULL DEFAULT 0,
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_alert_events_user_time ON alert_events (user_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_alert_events_rule ON alert_events (rule_id);
@@ -0,0 +1,13 @@
+-- Адреса исходящих уведомлений пользователей; secret подписывает тело запроса (HMAC-SHA256)
+CREATE TABLE IF NOT EXISTS webhooks (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    notify_alerts BOOLEAN NOT NULL DEFAULT TRUE,
+    notify_storms BOOLEAN NOT NULL DEFAULT FALSE,
+    region VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_webhooks_user ON webhooks (user_id);
+CREATE INDEX IF NOT EXISTS idx_webhooks_storms ON webhooks (notify_storms, region);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS watch_zones;
+ALTER TABLE webhooks DROP COLUMN notify_zones;
@@ -0,0 +1,22 @@
+-- Зоны наблюдения пользователей: круг (center_*, radius_km) или многоугольник (polygon — JSON [{"lat","lon"}]).
+-- min_*/max_* — описанный прямоугольник, по которому выбираются зоны-кандидаты для точки
+CREATE TABLE IF NOT EXISTS watch_zones (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    shape VARCHAR(16) NOT NULL,
+    center_lat DOUBLE PRECISION NOT NULL DEFAULT 0,
+    center_lon DOUBLE PRECISION NOT NULL DEFAULT 0,
+    radius_km DOUBLE PRECISION NOT NULL DEFAULT 0,
+    polygon TEXT,
+    min_wind_kmh REAL NOT NULL DEFAULT 0,
+    min_lat DOUBLE PRECISION NOT NULL,
+    max_lat DOUBLE PRECISION NOT NULL,
+    min_lon DOUBLE PRECISION NOT NULL,
+    max_lon DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_watch_zones_user ON watch_zones (user_id);
+CREATE INDEX IF NOT EXISTS idx_watch_zones_lat ON watch_zones (min_lat, max_lat);
+
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS notify_zones BOOLEAN NOT NULL DEFAULT FALSE;
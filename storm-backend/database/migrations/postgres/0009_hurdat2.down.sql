@@ -0,0 +1,11 @@
+DELETE FROM storm_track_points WHERE storm_id IN (SELECT storm_id FROM storms WHERE source <> 'live');
+DELETE FROM storms WHERE source <> 'live';
+DROP INDEX IF EXISTS idx_storms_source;
+ALTER TABLE storm_track_points
+    DROP COLUMN record_id,
+    DROP COLUMN system_status,
+    DROP COLUMN wind_radii;
+ALTER TABLE storms
+    DROP COLUMN name,
+    DROP COLUMN source,
+    ADD CONSTRAINT unique_region_timestamp UNIQUE (region, timestamp);
@@ -0,0 +1,14 @@
+-- Исторические штормы из HURDAT2 рядом с обнаруженными детектором. Уникальность (region, timestamp)
+-- мешает двум штормам бассейна с одним сроком начала; идентификатор шторма теперь storm_id
+ALTER TABLE storms
+    DROP CONSTRAINT IF EXISTS unique_region_timestamp,
+    ADD COLUMN name VARCHAR(64) NULL,
+    ADD COLUMN source VARCHAR(16) NOT NULL DEFAULT 'live';
+
+-- Поля точек best track: идентификатор записи (L — выход на сушу и т.д.), статус системы и радиусы ветра (JSON)
+ALTER TABLE storm_track_points
+    ADD COLUMN record_id VARCHAR(1) NULL,
+    ADD COLUMN system_status VARCHAR(2) NULL,
+    ADD COLUMN wind_radii TEXT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_storms_source ON storms (source);
@@ -0,0 +1,167 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Пакетная вставка замеров одним запросом; повторы пропускаются диалектным способом
+// (INSERT IGNORE в MySQL, ON CONFLICT DO NOTHING в PostgreSQL)
+func insertObservations(ctx context.Context, db *sql.DB, d *dialect, observations []models.Observation) error {
+	if len(observations) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	query.WriteString(d.insertIgnore)
+	query.WriteString(` INTO observations
+        (region, point, latitude, longitude, temp, humidity, wind_kmh, pressure, provider, observed_at) VALUES `)
+	args := make([]interface{}, 0, len(observations)*10)
+	for i, o := range observations {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		args = append(args, o.Region, o.Point, o.Lat, o.Lon, o.Temp, o.Humidity, o.WindKmH, nullPressure(o.Pressure), o.Provider, o.ObservedAt.UTC())
+	}
+	query.WriteString(d.ignoreConflict)
+
+	if _, err := db.ExecContext(ctx, d.bind(query.String()), args...); err != nil {
+		return fmt.Errorf("failed to insert %d observations: %w", len(observations), err)
+	}
+	return nil
+}
+
+// Агрегация по интервалам. bucketExpr — диалектное выражение начала интервала в секундах от эпохи
+func queryBuckets(ctx context.Context, db *sql.DB, bind func(string) string, bucketExpr string, bucketArgs []interface{}, q ObservationQuery) ([]models.ObservationBucket, error) {
+	query := `
+    SELECT ` + bucketExpr + ` AS bucket,
+        COUNT(*),
+        MIN(temp), MAX(temp), AVG(temp),
+        MIN(humidity), MAX(humidity), AVG(humidity),
+        MIN(wind_kmh), MAX(wind_kmh), AVG(wind_kmh),
+        COUNT(pressure), MIN(pressure), MAX(pressure), AVG(pressure)
+    FROM observations
+    WHERE region = ? AND observed_at >= ? AND observed_at < ?`
+	args := append(bucketArgs, q.Region, q.From.UTC(), q.To.UTC())
+	if q.Point != "" {
+		query += " AND point = ?"
+		args = append(args, q.Point)
+	}
+	query += " GROUP BY bucket ORDER BY bucket LIMIT ?"
+	args = append(args, q.Limit)
+
+	rows, err := db.QueryContext(ctx, bind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query observations: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []models.ObservationBucket
+	for rows.Next() {
+		var (
+			b             models.ObservationBucket
+			start         int64
+			pressureCount int
+			pressure      [3]sql.NullFloat64
+		)
+		err := rows.Scan(&start, &b.Samples,
+			&b.Temp.Min, &b.Temp.Max, &b.Temp.Avg,
+			&b.Humidity.Min, &b.Humidity.Max, &b.Humidity.Avg,
+			&b.WindKmH.Min, &b.WindKmH.Max, &b.WindKmH.Avg,
+			&pressureCount, &pressure[0], &pressure[1], &pressure[2])
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan observation bucket: %w", err)
+		}
+		b.Start = time.Unix(start, 0).UTC()
+		if pressureCount > 0 {
+			b.Pressure = &models.MetricStats{Min: pressure[0].Float64, Max: pressure[1].Float64, Avg: pressure[2].Float64}
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, rows.Err()
+}
+
+// Замеры без агрегации с курсором (observed_at, id)
+func queryRawObservations(ctx context.Context, db *sql.DB, bind func(string) string, q ObservationQuery) ([]models.ObservationBucket, error) {
+	query := `
+    SELECT id, point, provider, observed_at, temp, humidity, wind_kmh, pressure
+    FROM observations
+    WHERE region = ? AND (observed_at > ? OR (observed_at = ? AND id > ?)) AND observed_at < ?`
+	args := []interface{}{q.Region, q.From.UTC(), q.From.UTC(), q.AfterID, q.To.UTC()}
+	if q.Point != "" {
+		query += " AND point = ?"
+		args = append(args, q.Point)
+	}
+	query += " ORDER BY observed_at, id LIMIT ?"
+	args = append(args, q.Limit)
+
+	rows, err := db.QueryContext(ctx, bind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query observations: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []models.ObservationBucket
+	for rows.Next() {
+		var (
+			b                    models.ObservationBucket
+			temp, humidity, wind float64
+			pressure             sql.NullFloat64
+		)
+		if err := rows.Scan(&b.ID, &b.Point, &b.Provider, &b.Start, &temp, &humidity, &wind, &pressure); err != nil {
+			return nil, fmt.Errorf("failed to scan observation: %w", err)
+		}
+		b.Start = b.Start.UTC()
+		b.Samples = 1
+		b.Temp = models.MetricStats{Min: temp, Max: temp, Avg: temp}
+		b.Humidity = models.MetricStats{Min: humidity, Max: humidity, Avg: humidity}
+		b.WindKmH = models.MetricStats{Min: wind, Max: wind, Avg: wind}
+		if pressure.Valid {
+			b.Pressure = &models.MetricStats{Min: pressure.Float64, Max: pressure.Float64, Avg: pressure.Float64}
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, rows.Err()
+}
+
+// Построчное чтение замеров для выгрузки: строки передаются fn по мере чтения из курсора
+func eachObservation(ctx context.Context, db *sql.DB, bind func(string) string, q ObservationQuery, fn func(models.Observation) error) error {
+	query := `
+    SELECT region, point, latitude, longitude, temp, humidity, wind_kmh, pressure, provider, observed_at
+    FROM observations
+    WHERE region = ? AND observed_at >= ? AND observed_at < ?`
+	args := []interface{}{q.Region, q.From.UTC(), q.To.UTC()}
+	if q.Point != "" {
+		query += " AND point = ?"
+		args = append(args, q.Point)
+	}
+	query += " ORDER BY observed_at, id"
+
+	rows, err := db.QueryContext(ctx, bind(query), args...)
+	if err != nil {
+		return fmt.Errorf("failed to query observations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			o        models.Observation
+			pressure sql.NullFloat64
+		)
+		if err := rows.Scan(&o.Region, &o.Point, &o.Lat, &o.Lon, &o.Temp, &o.Humidity, &o.WindKmH, &pressure, &o.Provider, &o.ObservedAt); err != nil {
+			return fmt.Errorf("failed to scan observation: %w", err)
+		}
+		o.ObservedAt = o.ObservedAt.UTC()
+		o.Pressure = float32(pressure.Float64)
+		if err := fn(o); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
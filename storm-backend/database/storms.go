@@ -0,0 +1,295 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Столбцы шторма в порядке сканирования scanStorm
+const stormColumns = `storm_id, region, status, started_at, ended_at, updated_at,
+        latitude, longitude, wind_speed, peak_wind_kmh, peak_at, min_pressure,
+        peak_beaufort, peak_category, severity, name, source`
+
+// Общий интерфейс *sql.DB и *sql.Tx для запросов, которые выполняются и в транзакции
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func (s *sqlStore) CreateStorm(ctx context.Context, storm *models.Storm) error {
+	return s.createStorm(ctx, s.db, storm)
+}
+
+func (s *sqlStore) createStorm(ctx context.Context, db execer, storm *models.Storm) error {
+	source := storm.Source
+	if source == "" {
+		source = models.StormSourceLive
+	}
+	query := `INSERT INTO storms
+        (storm_id, region, status, started_at, ended_at, updated_at, latitude, longitude, wind_speed, peak_wind_kmh, peak_at, min_pressure,
+        peak_beaufort, peak_category, severity, name, source, timestamp)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := db.ExecContext(ctx, s.dialect.bind(query),
+		storm.ID, storm.Region, storm.Status, storm.StartedAt.UTC(), nullEndedAt(storm), storm.UpdatedAt.UTC(),
+		storm.Lat, storm.Lon, int(math.Round(float64(storm.WindKmH))),
+		storm.PeakWindKmH, storm.PeakAt.UTC(), nullPressure(storm.MinPressure),
+		storm.Beaufort, storm.Category, storm.Severity, nullString(storm.Name), source,
+		storm.StartedAt.UTC()) // timestamp — время создания записи, как и раньше
+	if err != nil {
+		return fmt.Errorf("failed to create storm %s: %w", storm.ID, err)
+	}
+	return nil
+}
+
+func (s *sqlStore) UpdateStorm(ctx context.Context, storm *models.Storm) error {
+	query := `UPDATE storms SET
+        status = ?, ended_at = ?, updated_at = ?, latitude = ?, longitude = ?, wind_speed = ?,
+        peak_wind_kmh = ?, peak_at = ?, min_pressure = ?, peak_beaufort = ?, peak_category = ?, severity = ?
+        WHERE storm_id = ?`
+	res, err := s.db.ExecContext(ctx, s.dialect.bind(query),
+		storm.Status, nullEndedAt(storm), storm.UpdatedAt.UTC(), storm.Lat, storm.Lon, int(math.Round(float64(storm.WindKmH))),
+		storm.PeakWindKmH, storm.PeakAt.UTC(), nullPressure(storm.MinPressure),
+		storm.Beaufort, storm.Category, storm.Severity,
+		storm.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update storm %s: %w", storm.ID, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		// MySQL не считает строку затронутой, если значения не изменились, поэтому проверяем наличие отдельно
+		if _, err := s.GetStorm(ctx, storm.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqlStore) GetStorm(ctx context.Context, id string) (*models.Storm, error) {
+	row := s.db.QueryRowContext(ctx, s.dialect.bind(`SELECT `+stormColumns+` FROM storms WHERE storm_id = ?`), id)
+	storm, err := scanStorm(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storm %s: %w", id, err)
+	}
+	return storm, nil
+}
+
+func (s *sqlStore) AddTrackPoint(ctx context.Context, stormID string, point models.TrackPoint) error {
+	return s.addTrackPoint(ctx, s.db, stormID, point)
+}
+
+func (s *sqlStore) addTrackPoint(ctx context.Context, db execer, stormID string, point models.TrackPoint) error {
+	var radii interface{} // NULL, если радиусы ветра не сообщались
+	if point.WindRadii != nil {
+		encoded, err := json.Marshal(point.WindRadii)
+		if err != nil {
+			return fmt.Errorf("failed to encode wind radii of storm %s: %w", stormID, err)
+		}
+		radii = string(encoded)
+	}
+	query := s.dialect.insertIgnore + ` INTO storm_track_points
+        (storm_id, observed_at, point, latitude, longitude, wind_kmh, pressure, record_id, system_status, wind_radii)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)` + s.dialect.ignoreConflict
+	_, err := db.ExecContext(ctx, s.dialect.bind(query),
+		stormID, point.ObservedAt.UTC(), point.Point, point.Lat, point.Lon, point.WindKmH, nullPressure(point.Pressure),
+		nullString(point.Record), nullString(point.Status), radii)
+	if err != nil {
+		return fmt.Errorf("failed to add track point to storm %s: %w", stormID, err)
+	}
+	return nil
+}
+
+// Общий сканер для *sql.Row и *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanStorm(row rowScanner) (*models.Storm, error) {
+	var (
+		storm       models.Storm
+		endedAt     sql.NullTime
+		updatedAt   sql.NullTime
+		peakWind    sql.NullFloat64
+		peakAt      sql.NullTime
+		minPressure sql.NullFloat64
+		wind        int
+		name        sql.NullString
+	)
+	err := row.Scan(&storm.ID, &storm.Region, &storm.Status, &storm.StartedAt, &endedAt, &updatedAt,
+		&storm.Lat, &storm.Lon, &wind, &peakWind, &peakAt, &minPressure,
+		&storm.Beaufort, &storm.Category, &storm.Severity, &name, &storm.Source)
+	if err != nil {
+		return nil, err
+	}
+	storm.StartedAt = storm.StartedAt.UTC()
+	if endedAt.Valid {
+		ended := endedAt.Time.UTC()
+		storm.EndedAt = &ended
+	}
+	storm.UpdatedAt = updatedAt.Time.UTC()
+	storm.WindKmH = float32(wind)
+	storm.PeakWindKmH = float32(peakWind.Float64)
+	storm.PeakAt = peakAt.Time.UTC()
+	storm.MinPressure = float32(minPressure.Float64)
+	storm.Name = name.String
+	return &storm, nil
+}
+
+// NULL для открытого шторма
+func nullEndedAt(storm *models.Storm) interface{} {
+	if storm.EndedAt == nil {
+		return nil
+	}
+	return storm.EndedAt.UTC()
+}
+
+// NULL вместо пустой строки
+func nullString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// NULL, если давление не сообщалось
+func nullPressure(pressure float32) interface{} {
+	if pressure == 0 {
+		return nil
+	}
+	return pressure
+}
+
+func (s *sqlStore) ListStorms(ctx context.Context, filter StormFilter) ([]models.Storm, error) {
+	var (
+		conds []string
+		args  []interface{}
+	)
+	if filter.Status != "" {
+		conds = append(conds, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.Region != "" {
+		conds = append(conds, "region = ?")
+		args = append(args, filter.Region)
+	}
+	if !filter.From.IsZero() { // Открытый шторм продолжается до сих пор
+		conds = append(conds, "(ended_at IS NULL OR ended_at >= ?)")
+		args = append(args, filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		conds = append(conds, "started_at < ?")
+		args = append(args, filter.To.UTC())
+	}
+	if filter.Source != "" {
+		conds = append(conds, "source = ?")
+		args = append(args, filter.Source)
+	}
+	if filter.MinCategory > 0 {
+		conds = append(conds, "peak_category >= ?")
+		args = append(args, filter.MinCategory)
+	}
+
+	query := `SELECT ` + stormColumns + ` FROM storms`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY started_at DESC, storm_id LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.db.QueryContext(ctx, s.dialect.bind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storms: %w", err)
+	}
+	defer rows.Close()
+
+	var storms []models.Storm
+	for rows.Next() {
+		storm, err := scanStorm(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read storm: %w", err)
+		}
+		storms = append(storms, *storm)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list storms: %w", err)
+	}
+	return storms, nil
+}
+
+func (s *sqlStore) GetTrack(ctx context.Context, stormID string) ([]models.TrackPoint, error) {
+	// Отличаем шторм без точек от несуществующего
+	if _, err := s.GetStorm(ctx, stormID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, s.dialect.bind(`SELECT observed_at, point, latitude, longitude, wind_kmh, pressure,
+        record_id, system_status, wind_radii
+        FROM storm_track_points WHERE storm_id = ? ORDER BY observed_at, point`), stormID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read track of storm %s: %w", stormID, err)
+	}
+	defer rows.Close()
+
+	var track []models.TrackPoint
+	for rows.Next() {
+		var (
+			point          models.TrackPoint
+			pressure       sql.NullFloat64
+			record, status sql.NullString
+			radii          sql.NullString
+		)
+		if err := rows.Scan(&point.ObservedAt, &point.Point, &point.Lat, &point.Lon, &point.WindKmH, &pressure,
+			&record, &status, &radii); err != nil {
+			return nil, fmt.Errorf("failed to read track point of storm %s: %w", stormID, err)
+		}
+		point.ObservedAt = point.ObservedAt.UTC()
+		point.Pressure = float32(pressure.Float64)
+		point.Record, point.Status = record.String, status.String
+		if radii.Valid {
+			point.WindRadii = &models.WindRadii{}
+			if err := json.Unmarshal([]byte(radii.String), point.WindRadii); err != nil {
+				return nil, fmt.Errorf("failed to decode wind radii of storm %s: %w", stormID, err)
+			}
+		}
+		track = append(track, point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read track of storm %s: %w", stormID, err)
+	}
+	return track, nil
+}
+
+// Замена шторма и всего его трека одной транзакцией: повторный импорт архива обновляет записи
+func (s *sqlStore) ImportStorm(ctx context.Context, storm *models.Storm, track []models.TrackPoint) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin import of storm %s: %w", storm.ID, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, s.dialect.bind(`DELETE FROM storm_track_points WHERE storm_id = ?`), storm.ID); err != nil {
+		return fmt.Errorf("failed to clear track of storm %s: %w", storm.ID, err)
+	}
+	if _, err := tx.ExecContext(ctx, s.dialect.bind(`DELETE FROM storms WHERE storm_id = ?`), storm.ID); err != nil {
+		return fmt.Errorf("failed to replace storm %s: %w", storm.ID, err)
+	}
+	if err := s.createStorm(ctx, tx, storm); err != nil {
+		return err
+	}
+	for _, point := range track {
+		if err := s.addTrackPoint(ctx, tx, storm.ID, point); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit import of storm %s: %w", storm.ID, err)
+	}
+	return nil
+}
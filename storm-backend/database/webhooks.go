@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Столбцы адреса в порядке сканирования scanWebhook
+const webhookColumns = `id, user_id, url, secret, notify_alerts, notify_storms, notify_zones, region, created_at`
+
+func (s *sqlStore) CreateWebhook(ctx context.Context, hook *models.Webhook) error {
+	id, err := s.insertID(ctx, `INSERT INTO webhooks
+        (user_id, url, secret, notify_alerts, notify_storms, notify_zones, region, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		hook.UserID, hook.URL, hook.Secret, hook.Alerts, hook.Storms, hook.Zones, hook.Region, hook.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+	hook.ID = id
+	return nil
+}
+
+func (s *sqlStore) ListWebhooks(ctx context.Context, userID string) ([]models.Webhook, error) {
+	return s.queryWebhooks(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE user_id = ? ORDER BY id`, userID)
+}
+
+func (s *sqlStore) StormWebhooks(ctx context.Context, region string) ([]models.Webhook, error) {
+	return s.queryWebhooks(ctx, `SELECT `+webhookColumns+` FROM webhooks
+        WHERE notify_storms = ? AND (region = '' OR region = ?) ORDER BY id`, true, region)
+}
+
+func (s *sqlStore) GetWebhook(ctx context.Context, id int64) (*models.Webhook, error) {
+	row := s.db.QueryRowContext(ctx, s.dialect.bind(`SELECT `+webhookColumns+` FROM webhooks WHERE id = ?`), id)
+	hook, err := scanWebhook(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook %d: %w", id, err)
+	}
+	return hook, nil
+}
+
+func (s *sqlStore) DeleteWebhook(ctx context.Context, userID string, id int64) error {
+	res, err := s.db.ExecContext(ctx, s.dialect.bind(`DELETE FROM webhooks WHERE id = ? AND user_id = ?`), id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook %d: %w", id, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlStore) queryWebhooks(ctx context.Context, query string, args ...interface{}) ([]models.Webhook, error) {
+	rows, err := s.db.QueryContext(ctx, s.dialect.bind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var hooks []models.Webhook
+	for rows.Next() {
+		hook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read webhook: %w", err)
+		}
+		hooks = append(hooks, *hook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	return hooks, nil
+}
+
+func scanWebhook(row rowScanner) (*models.Webhook, error) {
+	var hook models.Webhook
+	err := row.Scan(&hook.ID, &hook.UserID, &hook.URL, &hook.Secret, &hook.Alerts, &hook.Storms, &hook.Zones, &hook.Region, &hook.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	hook.CreatedAt = hook.CreatedAt.UTC()
+	return &hook, nil
+}
@@ -0,0 +1,108 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Столбцы зоны в порядке сканирования scanWatchZone
+const watchZoneColumns = `id, user_id, name, shape, center_lat, center_lon, radius_km, polygon, min_wind_kmh,
+        min_lat, max_lat, min_lon, max_lon, created_at`
+
+func (s *sqlStore) CreateWatchZone(ctx context.Context, zone *models.WatchZone) error {
+	var polygon interface{} // NULL для круга
+	if len(zone.Polygon) > 0 {
+		encoded, err := json.Marshal(zone.Polygon)
+		if err != nil {
+			return fmt.Errorf("failed to encode zone polygon: %w", err)
+		}
+		polygon = string(encoded)
+	}
+	id, err := s.insertID(ctx, `INSERT INTO watch_zones
+        (user_id, name, shape, center_lat, center_lon, radius_km, polygon, min_wind_kmh, min_lat, max_lat, min_lon, max_lon, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		zone.UserID, zone.Name, zone.Shape, zone.Center.Lat, zone.Center.Lon, zone.RadiusKm, polygon, zone.MinWindKmH,
+		zone.Bounds.MinLat, zone.Bounds.MaxLat, zone.Bounds.MinLon, zone.Bounds.MaxLon, zone.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create watch zone: %w", err)
+	}
+	zone.ID = id
+	return nil
+}
+
+func (s *sqlStore) ListWatchZones(ctx context.Context, userID string) ([]models.WatchZone, error) {
+	return s.queryWatchZones(ctx, `SELECT `+watchZoneColumns+` FROM watch_zones WHERE user_id = ? ORDER BY id`, userID)
+}
+
+func (s *sqlStore) WatchZonesAt(ctx context.Context, lat, lon float64) ([]models.WatchZone, error) {
+	return s.queryWatchZones(ctx, `SELECT `+watchZoneColumns+` FROM watch_zones
+        WHERE min_lat <= ? AND max_lat >= ? AND min_lon <= ? AND max_lon >= ? ORDER BY id`, lat, lat, lon, lon)
+}
+
+func (s *sqlStore) GetWatchZone(ctx context.Context, id int64) (*models.WatchZone, error) {
+	row := s.db.QueryRowContext(ctx, s.dialect.bind(`SELECT `+watchZoneColumns+` FROM watch_zones WHERE id = ?`), id)
+	zone, err := scanWatchZone(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read watch zone %d: %w", id, err)
+	}
+	return zone, nil
+}
+
+func (s *sqlStore) DeleteWatchZone(ctx context.Context, userID string, id int64) error {
+	res, err := s.db.ExecContext(ctx, s.dialect.bind(`DELETE FROM watch_zones WHERE id = ? AND user_id = ?`), id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete watch zone %d: %w", id, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlStore) queryWatchZones(ctx context.Context, query string, args ...interface{}) ([]models.WatchZone, error) {
+	rows, err := s.db.QueryContext(ctx, s.dialect.bind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list watch zones: %w", err)
+	}
+	defer rows.Close()
+
+	var zones []models.WatchZone
+	for rows.Next() {
+		zone, err := scanWatchZone(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read watch zone: %w", err)
+		}
+		zones = append(zones, *zone)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list watch zones: %w", err)
+	}
+	return zones, nil
+}
+
+func scanWatchZone(row rowScanner) (*models.WatchZone, error) {
+	var (
+		zone    models.WatchZone
+		polygon sql.NullString
+	)
+	err := row.Scan(&zone.ID, &zone.UserID, &zone.Name, &zone.Shape, &zone.Center.Lat, &zone.Center.Lon, &zone.RadiusKm,
+		&polygon, &zone.MinWindKmH, &zone.Bounds.MinLat, &zone.Bounds.MaxLat, &zone.Bounds.MinLon, &zone.Bounds.MaxLon, &zone.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if polygon.Valid && polygon.String != "" {
+		if err := json.Unmarshal([]byte(polygon.String), &zone.Polygon); err != nil {
+			return nil, fmt.Errorf("invalid polygon of watch zone %d: %w", zone.ID, err)
+		}
+	}
+	zone.CreatedAt = zone.CreatedAt.UTC()
+	return &zone, nil
+}
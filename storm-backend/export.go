@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/export"
+)
+
+const exportUsage = `usage: storm-backend export <what> [options]
+
+what:
+  storm <id>        one storm with its track
+  storms            all open storms with their tracks
+  observations      raw observations of a region
+
+options:
+  -format F         geojson (default), kml or csv
+  -o FILE           output file (default: standard output)
+  -region ID        region of the storms (optional) or observations (required)
+  -from TIME        start of the observation range, RFC 3339 (default: 48 hours before -to)
+  -to TIME          end of the observation range, RFC 3339 (default: now)
+  -point ID         observations of one point only`
+
+// Подкоманда storm-backend export; возвращает код завершения процесса
+func runExport(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, exportUsage)
+		return 2
+	}
+	what := args[0]
+	flags := flag.NewFlagSet("export "+what, flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprintln(os.Stderr, exportUsage) }
+	format := flags.String("format", export.FormatGeoJSON, "geojson, kml or csv")
+	output := flags.String("o", "", "output file")
+	region := flags.String("region", "", "region")
+	fromFlag := flags.String("from", "", "start of the observation range")
+	toFlag := flags.String("to", "", "end of the observation range")
+	point := flags.String("point", "", "observation point")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	switch *format {
+	case export.FormatGeoJSON, export.FormatKML, export.FormatCSV:
+	default:
+		fmt.Fprintf(os.Stderr, "unsupported format %q\n", *format)
+		return 2
+	}
+
+	var (
+		kind    export.Kind
+		title   string
+		produce func(context.Context, database.Store, export.Writer) error
+	)
+	switch {
+	case what == "storm" && flags.NArg() == 1:
+		id := flags.Arg(0)
+		kind, title = export.Storms, "Storm "+id
+		produce = func(ctx context.Context, store database.Store, w export.Writer) error {
+			return export.Storm(ctx, store, id, w)
+		}
+	case what == "storms" && flags.NArg() == 0:
+		kind, title = export.Storms, "Active storms"
+		produce = func(ctx context.Context, store database.Store, w export.Writer) error {
+			return export.ActiveStorms(ctx, store, *region, w)
+		}
+	case what == "observations" && flags.NArg() == 0 && *region != "":
+		to := time.Now().UTC()
+		if *toFlag != "" {
+			parsed, err := time.Parse(time.RFC3339, *toFlag)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "invalid -to: %v\n", err)
+				return 2
+			}
+			to = parsed
+		}
+		from := to.Add(-48 * time.Hour)
+		if *fromFlag != "" {
+			parsed, err := time.Parse(time.RFC3339, *fromFlag)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "invalid -from: %v\n", err)
+				return 2
+			}
+			from = parsed
+		}
+		if !from.Before(to) {
+			fmt.Fprintln(os.Stderr, "-from must be before -to")
+			return 2
+		}
+		q := database.ObservationQuery{Region: *region, Point: *point, From: from, To: to}
+		kind, title = export.Observations, *region+" observations"
+		produce = func(ctx context.Context, store database.Store, w export.Writer) error {
+			return export.ObservationRange(ctx, store, q, w)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, exportUsage)
+		return 2
+	}
+
+	if err := database.Connect(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer database.CloseDB()
+
+	var dst io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer file.Close()
+		dst = file
+	}
+	buffered := bufio.NewWriter(dst)
+	w, err := export.New(*format, kind, buffered, title)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	err = produce(context.Background(), database.DB, w)
+	if err == nil {
+		err = w.Close()
+	}
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if errors.Is(err, database.ErrNotFound) {
+		fmt.Fprintf(os.Stderr, "storm %q not found\n", flags.Arg(0))
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
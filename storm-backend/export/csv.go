@@ -0,0 +1,77 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Заголовки CSV: у штормов строка на точку трека, у замеров строка на замер
+var (
+	stormColumns       = []string{"storm_id", "name", "region", "source", "status", "observed_at", "lat", "lon", "wind_kmh", "pressure", "system_status", "record"}
+	observationColumns = []string{"region", "point", "provider", "observed_at", "lat", "lon", "temp", "humidity", "wind_kmh", "pressure"}
+)
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// Заголовок пишется сразу, чтобы пустая выгрузка оставалась корректным CSV
+func newCSVWriter(w io.Writer, kind Kind) (*csvWriter, error) {
+	c := &csvWriter{w: csv.NewWriter(w)}
+	header := stormColumns
+	if kind == Observations {
+		header = observationColumns
+	}
+	if err := c.w.Write(header); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *csvWriter) Storm(storm *models.Storm, track []models.TrackPoint) error {
+	for _, p := range track {
+		err := c.w.Write([]string{
+			storm.ID,
+			storm.Name,
+			storm.Region,
+			storm.Source,
+			storm.Status,
+			p.ObservedAt.UTC().Format(time.RFC3339),
+			formatFloat(p.Lat),
+			formatFloat(p.Lon),
+			formatFloat(p.WindKmH),
+			pressure(p.Pressure),
+			p.Status,
+			p.Record,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Observation(o models.Observation) error {
+	return c.w.Write([]string{
+		o.Region,
+		o.Point,
+		o.Provider,
+		o.ObservedAt.UTC().Format(time.RFC3339),
+		formatFloat(o.Lat),
+		formatFloat(o.Lon),
+		formatFloat(o.Temp),
+		strconv.Itoa(o.Humidity),
+		formatFloat(o.WindKmH),
+		pressure(o.Pressure),
+	})
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
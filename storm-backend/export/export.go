@@ -0,0 +1,109 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Форматы выгрузки
+const (
+	FormatGeoJSON = "geojson" // FeatureCollection (RFC 7946)
+	FormatKML     = "kml"     // KML 2.2 с TimeSpan для ползунка времени Google Earth
+	FormatCSV     = "csv"     // Одна строка на точку трека или замер
+)
+
+// Что выгружается; от этого зависят столбцы CSV
+type Kind int
+
+const (
+	Storms Kind = iota
+	Observations
+)
+
+// Потоковая запись выгрузки: объекты пишутся по мере чтения из базы, Close дописывает окончание файла
+type Writer interface {
+	Storm(storm *models.Storm, track []models.TrackPoint) error
+	Observation(o models.Observation) error
+	Close() error
+}
+
+// Выгрузка в формате format; title — заголовок документа KML
+func New(format string, kind Kind, w io.Writer, title string) (Writer, error) {
+	switch format {
+	case FormatGeoJSON:
+		return newGeoJSONWriter(w), nil
+	case FormatKML:
+		return newKMLWriter(w, title), nil
+	case FormatCSV:
+		return newCSVWriter(w, kind)
+	default:
+		return nil, fmt.Errorf("unsupported format %q, expected %s, %s or %s", format, FormatGeoJSON, FormatKML, FormatCSV)
+	}
+}
+
+// MIME-тип и расширение файла формата
+func ContentType(format string) string {
+	switch format {
+	case FormatKML:
+		return "application/vnd.google-earth.kml+xml"
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	default:
+		return "application/geo+json"
+	}
+}
+
+func Extension(format string) string {
+	if format == FormatGeoJSON {
+		return "geojson"
+	}
+	return format
+}
+
+// Один шторм с треком; ErrNotFound, если шторма нет
+func Storm(ctx context.Context, store database.Store, id string, w Writer) error {
+	storm, err := store.GetStorm(ctx, id)
+	if err != nil {
+		return err
+	}
+	track, err := store.GetTrack(ctx, id)
+	if err != nil {
+		return err
+	}
+	return w.Storm(storm, track)
+}
+
+// Открытые штормы региона (всех регионов, если region пуст) с треками, по одному шторму в памяти
+func ActiveStorms(ctx context.Context, store database.Store, region string, w Writer) error {
+	storms, err := store.ListStorms(ctx, database.StormFilter{Status: models.StormOpen, Region: region, Limit: maxActiveStorms})
+	if err != nil {
+		return err
+	}
+	for i := range storms {
+		track, err := store.GetTrack(ctx, storms[i].ID)
+		if err != nil {
+			return err
+		}
+		if err := w.Storm(&storms[i], track); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Замеры региона за интервал q.From–q.To построчно
+func ObservationRange(ctx context.Context, store database.Store, q database.ObservationQuery, w Writer) error {
+	return store.EachObservation(ctx, q, w.Observation)
+}
+
+const maxActiveStorms = 1000 // Как предельный размер страницы ListStorms
+
+// Число для CSV и KML без лишних знаков float32
+func formatFloat(v float32) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
@@ -0,0 +1,165 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+// FeatureCollection пишется по одному объекту: у шторма линия трека (kind track) и точки фиксаций (kind fix),
+// у замера — точка (kind observation). Точки с временем подходят для временного контроллера QGIS
+type geojsonWriter struct {
+	w       io.Writer
+	started bool
+	count   int
+}
+
+func newGeoJSONWriter(w io.Writer) *geojsonWriter {
+	return &geojsonWriter{w: w}
+}
+
+type feature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Geometry   geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+func (g *geojsonWriter) Storm(storm *models.Storm, track []models.TrackPoint) error {
+	line := make([][2]float64, 0, len(track))
+	for _, p := range track {
+		line = append(line, lonLat(p.Lat, p.Lon))
+	}
+	shape := geometry{Type: "LineString", Coordinates: line}
+	switch len(line) {
+	case 0:
+		shape = geometry{Type: "Point", Coordinates: lonLat(storm.Lat, storm.Lon)}
+	case 1:
+		shape = geometry{Type: "Point", Coordinates: line[0]}
+	}
+	properties := map[string]interface{}{
+		"kind":          "track",
+		"storm_id":      storm.ID,
+		"name":          nullable(storm.Name),
+		"source":        storm.Source,
+		"region":        storm.Region,
+		"status":        storm.Status,
+		"started_at":    storm.StartedAt.Format(time.RFC3339),
+		"ended_at":      nil,
+		"peak_wind_kmh": number(storm.PeakWindKmH),
+		"peak_at":       storm.PeakAt.Format(time.RFC3339),
+		"min_pressure":  nil,
+		"beaufort":      storm.Beaufort,
+		"category":      storm.Category,
+	}
+	if storm.EndedAt != nil {
+		properties["ended_at"] = storm.EndedAt.Format(time.RFC3339)
+	}
+	if storm.MinPressure != 0 {
+		properties["min_pressure"] = number(storm.MinPressure)
+	}
+	if err := g.write(feature{Type: "Feature", ID: storm.ID, Geometry: shape, Properties: properties}); err != nil {
+		return err
+	}
+
+	for _, p := range track {
+		fix := map[string]interface{}{
+			"kind":          "fix",
+			"storm_id":      storm.ID,
+			"time":          p.ObservedAt.Format(time.RFC3339),
+			"point":         p.Point,
+			"wind_kmh":      number(p.WindKmH),
+			"pressure":      nil,
+			"system_status": nullable(p.Status),
+			"record":        nullable(p.Record),
+		}
+		if p.Pressure != 0 {
+			fix["pressure"] = number(p.Pressure)
+		}
+		if err := g.write(feature{Type: "Feature", Geometry: geometry{Type: "Point", Coordinates: lonLat(p.Lat, p.Lon)}, Properties: fix}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *geojsonWriter) Observation(o models.Observation) error {
+	properties := map[string]interface{}{
+		"kind":     "observation",
+		"region":   o.Region,
+		"point":    o.Point,
+		"provider": o.Provider,
+		"time":     o.ObservedAt.Format(time.RFC3339),
+		"temp":     number(o.Temp),
+		"humidity": o.Humidity,
+		"wind_kmh": number(o.WindKmH),
+		"pressure": nil,
+	}
+	if o.Pressure != 0 {
+		properties["pressure"] = number(o.Pressure)
+	}
+	return g.write(feature{Type: "Feature", Geometry: geometry{Type: "Point", Coordinates: lonLat(o.Lat, o.Lon)}, Properties: properties})
+}
+
+func (g *geojsonWriter) Close() error {
+	if err := g.begin(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(g.w, "\n]}\n")
+	return err
+}
+
+func (g *geojsonWriter) begin() error {
+	if g.started {
+		return nil
+	}
+	g.started = true
+	_, err := io.WriteString(g.w, `{"type":"FeatureCollection","features":[`)
+	return err
+}
+
+func (g *geojsonWriter) write(f feature) error {
+	if err := g.begin(); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	separator := ",\n"
+	if g.count == 0 {
+		separator = "\n"
+	}
+	g.count++
+	if _, err := io.WriteString(g.w, separator); err != nil {
+		return err
+	}
+	_, err = g.w.Write(encoded)
+	return err
+}
+
+// Координаты [lon, lat] без шума двоичного представления float32
+func lonLat(lat, lon float32) [2]float64 {
+	return [2]float64{number(lon), number(lat)}
+}
+
+func number(v float32) float64 {
+	f, _ := strconv.ParseFloat(formatFloat(v), 64)
+	return f
+}
+
+// null вместо пустой строки
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,172 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+// KML 2.2: у каждой метки TimeSpan, чтобы Google Earth показывал ползунок времени.
+// Шторм — папка с линией трека на всё время жизни и метками фиксаций, каждая видна до следующей фиксации.
+// Замер виден до следующего замера той же точки, поэтому последний замер каждой точки держится до Close
+type kmlWriter struct {
+	w       io.Writer
+	title   string
+	started bool
+	err     error
+
+	pending map[string]models.Observation // Последний ещё не записанный замер по region/point
+	order   []string                      // Порядок точек для детерминированного вывода в Close
+}
+
+func newKMLWriter(w io.Writer, title string) *kmlWriter {
+	return &kmlWriter{w: w, title: title, pending: make(map[string]models.Observation)}
+}
+
+func (k *kmlWriter) Storm(storm *models.Storm, track []models.TrackPoint) error {
+	k.begin()
+	name := storm.Name
+	if name == "" {
+		name = storm.ID
+	}
+	k.printf("<Folder><name>%s</name>\n", escape(name))
+
+	end := storm.EndedAt
+	k.printf("<Placemark><name>%s</name>", escape(name))
+	k.timeSpan(storm.StartedAt, end)
+	k.extendedData(
+		"storm_id", storm.ID,
+		"region", storm.Region,
+		"source", storm.Source,
+		"status", storm.Status,
+		"peak_wind_kmh", formatFloat(storm.PeakWindKmH),
+		"min_pressure", pressure(storm.MinPressure),
+		"category", strconv.Itoa(storm.Category),
+	)
+	if len(track) > 1 {
+		coordinates := make([]string, 0, len(track))
+		for _, p := range track {
+			coordinates = append(coordinates, coordinate(p.Lat, p.Lon))
+		}
+		k.printf("<LineString><tessellate>1</tessellate><coordinates>%s</coordinates></LineString>", strings.Join(coordinates, " "))
+	} else {
+		k.printf("<Point><coordinates>%s</coordinates></Point>", coordinate(storm.Lat, storm.Lon))
+	}
+	k.printf("</Placemark>\n")
+
+	for i, p := range track {
+		fixEnd := end
+		if i+1 < len(track) {
+			fixEnd = &track[i+1].ObservedAt
+		}
+		k.printf("<Placemark><name>%s</name>", p.ObservedAt.Format(time.RFC3339))
+		k.timeSpan(p.ObservedAt, fixEnd)
+		k.extendedData(
+			"wind_kmh", formatFloat(p.WindKmH),
+			"pressure", pressure(p.Pressure),
+			"system_status", p.Status,
+			"record", p.Record,
+		)
+		k.printf("<Point><coordinates>%s</coordinates></Point></Placemark>\n", coordinate(p.Lat, p.Lon))
+	}
+	k.printf("</Folder>\n")
+	return k.err
+}
+
+func (k *kmlWriter) Observation(o models.Observation) error {
+	k.begin()
+	key := o.Region + "/" + o.Point
+	previous, ok := k.pending[key]
+	if !ok {
+		k.order = append(k.order, key)
+	} else {
+		k.observation(previous, &o.ObservedAt)
+	}
+	k.pending[key] = o
+	return k.err
+}
+
+func (k *kmlWriter) Close() error {
+	k.begin()
+	for _, key := range k.order {
+		k.observation(k.pending[key], nil)
+	}
+	k.pending, k.order = nil, nil
+	k.printf("</Document>\n</kml>\n")
+	return k.err
+}
+
+func (k *kmlWriter) observation(o models.Observation, end *time.Time) {
+	k.printf("<Placemark><name>%s</name>", escape(o.Point))
+	k.timeSpan(o.ObservedAt, end)
+	k.extendedData(
+		"region", o.Region,
+		"provider", o.Provider,
+		"temp", formatFloat(o.Temp),
+		"humidity", strconv.Itoa(o.Humidity),
+		"wind_kmh", formatFloat(o.WindKmH),
+		"pressure", pressure(o.Pressure),
+	)
+	k.printf("<Point><coordinates>%s</coordinates></Point></Placemark>\n", coordinate(o.Lat, o.Lon))
+}
+
+func (k *kmlWriter) begin() {
+	if k.started {
+		return
+	}
+	k.started = true
+	k.printf("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<kml xmlns=\"http://www.opengis.net/kml/2.2\">\n<Document><name>%s</name>\n", escape(k.title))
+}
+
+// Без end интервал открыт: метка видна до конца шкалы времени
+func (k *kmlWriter) timeSpan(begin time.Time, end *time.Time) {
+	k.printf("<TimeSpan><begin>%s</begin>", begin.UTC().Format(time.RFC3339))
+	if end != nil && end.After(begin) {
+		k.printf("<end>%s</end>", end.UTC().Format(time.RFC3339))
+	}
+	k.printf("</TimeSpan>")
+}
+
+// Пары имя–значение; пустые значения пропускаются
+func (k *kmlWriter) extendedData(pairs ...string) {
+	k.printf("<ExtendedData>")
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			continue
+		}
+		k.printf(`<Data name="%s"><value>%s</value></Data>`, pairs[i], escape(pairs[i+1]))
+	}
+	k.printf("</ExtendedData>")
+}
+
+// Первая ошибка записи запоминается, дальше запись не идёт
+func (k *kmlWriter) printf(format string, args ...interface{}) {
+	if k.err != nil {
+		return
+	}
+	_, k.err = fmt.Fprintf(k.w, format, args...)
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// Координата KML: долгота, широта
+func coordinate(lat, lon float32) string {
+	return formatFloat(lon) + "," + formatFloat(lat)
+}
+
+// Пустая строка, если давление неизвестно
+func pressure(v float32) string {
+	if v == 0 {
+		return ""
+	}
+	return formatFloat(v)
+}
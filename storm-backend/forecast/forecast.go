@@ -0,0 +1,61 @@
+package forecast
+
+import (
+	"time"
+
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/intercept"
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Сроки прогноза и радиусы неопределённости на них, как у конусов NHC: радиус растёт
+// вместе с типичной ошибкой прогноза. Точки трека — узлы сетки региона, поэтому радиусы
+// с запасом больше шага сетки даже на первом часе
+var Leads = []struct {
+	Hours    int
+	RadiusKm float64
+}{
+	{1, 25},
+	{3, 45},
+	{6, 70},
+	{12, 120},
+}
+
+const circleVertices = 24 // Вершин окружности на каждом сроке при построении конуса
+
+// Прогноз по последним points точкам трека: шторм движется по большому кругу с оценённой
+// скоростью и азимутом, конус — выпуклая оболочка кругов неопределённости всех сроков.
+// nil, если точек меньше двух и движение не оценить
+func Project(track []models.TrackPoint, points int) *models.StormForecast {
+	if len(track) < 2 {
+		return nil
+	}
+	motion := intercept.EstimateMotion(track, points)
+	last := track[len(track)-1]
+	origin := geo.Point{Lat: float64(last.Lat), Lon: float64(last.Lon)}
+
+	f := &models.StormForecast{
+		IssuedAt: last.ObservedAt.UTC(),
+		Origin:   origin,
+		SpeedKmH: motion.SpeedKmH,
+		Heading:  motion.Heading,
+	}
+	outline := []geo.Point{origin}
+	for _, lead := range Leads {
+		center := geo.Destination(origin, motion.Heading, motion.SpeedKmH*float64(lead.Hours))
+		f.Positions = append(f.Positions, models.ForecastPosition{
+			LeadHours: lead.Hours,
+			ValidAt:   f.IssuedAt.Add(time.Duration(lead.Hours) * time.Hour),
+			Lat:       center.Lat,
+			Lon:       center.Lon,
+			RadiusKm:  lead.RadiusKm,
+		})
+		for _, p := range geo.Circle(center, lead.RadiusKm, circleVertices) {
+			// Долготы отсчитываются от начала трека, чтобы конус у антимеридиана не разрывался
+			p.Lon = origin.Lon + geo.NormalizeLon(p.Lon-origin.Lon)
+			outline = append(outline, p)
+		}
+	}
+	f.Cone = geo.ConvexHull(outline)
+	return f
+}
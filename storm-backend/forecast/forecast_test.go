@@ -0,0 +1,175 @@
+package forecast
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/models"
+)
+
+const kmPerDegree = 2 * math.Pi * geo.EarthRadiusKm / 360
+
+var start = time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+
+// Трек из точек (lat, lon) через каждый час
+func hourlyTrack(points ...[2]float32) []models.TrackPoint {
+	track := make([]models.TrackPoint, 0, len(points))
+	for i, p := range points {
+		track = append(track, models.TrackPoint{ObservedAt: start.Add(time.Duration(i) * time.Hour), Lat: p[0], Lon: p[1]})
+	}
+	return track
+}
+
+// Долгота, продолженная от origin без разрыва на антимеридиане
+func unwrap(lon, origin float64) float64 {
+	return origin + geo.NormalizeLon(lon-origin)
+}
+
+func TestProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		track   []models.TrackPoint
+		speed   float64
+		heading float64
+		// Ожидаемые центры по срокам Leads (долготы продолжены от начала трека)
+		centers []geo.Point
+	}{
+		{"empty track", nil, 0, 0, nil},
+		{"single point", hourlyTrack([2]float32{20, -60}), 0, 0, nil},
+		{
+			name:  "stationary storm",
+			track: hourlyTrack([2]float32{20, -60}, [2]float32{20, -60}),
+			centers: []geo.Point{
+				{Lat: 20, Lon: -60}, {Lat: 20, Lon: -60}, {Lat: 20, Lon: -60}, {Lat: 20, Lon: -60},
+			},
+		},
+		{
+			name:  "north at one degree an hour",
+			track: hourlyTrack([2]float32{0, -60}, [2]float32{1, -60}),
+			speed: kmPerDegree, heading: 0,
+			centers: []geo.Point{{Lat: 2, Lon: -60}, {Lat: 4, Lon: -60}, {Lat: 7, Lon: -60}, {Lat: 13, Lon: -60}},
+		},
+		{
+			// По экватору на восток: через 3 часа центр уже за антимеридианом
+			name:  "towards the antimeridian",
+			track: hourlyTrack([2]float32{0, 177}, [2]float32{0, 178}),
+			speed: kmPerDegree, heading: 90,
+			centers: []geo.Point{{Lat: 0, Lon: 179}, {Lat: 0, Lon: 181}, {Lat: 0, Lon: 184}, {Lat: 0, Lon: 190}},
+		},
+		{
+			// Трек уже пересёк ±180: от 179.5 до -179.5 — это градус на восток, а не 359 на запад
+			name:  "track crossing the antimeridian",
+			track: hourlyTrack([2]float32{0, 179.5}, [2]float32{0, -179.5}),
+			speed: kmPerDegree, heading: 90,
+			centers: []geo.Point{{Lat: 0, Lon: -178.5}, {Lat: 0, Lon: -176.5}, {Lat: 0, Lon: -173.5}, {Lat: 0, Lon: -167.5}},
+		},
+		{
+			// На запад через антимеридиан: конус продолжается за -180
+			name:  "westwards across the antimeridian",
+			track: hourlyTrack([2]float32{0, -178}, [2]float32{0, -179}),
+			speed: kmPerDegree, heading: 270,
+			centers: []geo.Point{{Lat: 0, Lon: -180}, {Lat: 0, Lon: -182}, {Lat: 0, Lon: -185}, {Lat: 0, Lon: -191}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Project(tt.track, 6)
+			if tt.centers == nil {
+				if f != nil {
+					t.Fatalf("Project = %+v, want nil for fewer than 2 points", f)
+				}
+				return
+			}
+			if f == nil {
+				t.Fatal("Project = nil")
+			}
+
+			last := tt.track[len(tt.track)-1]
+			origin := geo.Point{Lat: float64(last.Lat), Lon: float64(last.Lon)}
+			if !f.IssuedAt.Equal(last.ObservedAt) || f.Origin != origin {
+				t.Errorf("issued at %v from %v, want %v from %v", f.IssuedAt, f.Origin, last.ObservedAt, origin)
+			}
+			if math.Abs(f.SpeedKmH-tt.speed) > 0.01 || (tt.speed > 0 && math.Abs(f.Heading-tt.heading) > 0.01) {
+				t.Errorf("motion = %.3f km/h at %.2f, want %.3f at %.2f", f.SpeedKmH, f.Heading, tt.speed, tt.heading)
+			}
+
+			if len(f.Positions) != len(Leads) {
+				t.Fatalf("got %d positions, want %d", len(f.Positions), len(Leads))
+			}
+			for i, p := range f.Positions {
+				lead := Leads[i]
+				if p.LeadHours != lead.Hours || p.RadiusKm != lead.RadiusKm || !p.ValidAt.Equal(last.ObservedAt.Add(time.Duration(lead.Hours)*time.Hour)) {
+					t.Errorf("position %d = %+v", i, p)
+				}
+				// Положения — обычные координаты в [-180, 180)
+				if p.Lon < -180 || p.Lon >= 180 {
+					t.Errorf("+%dh: longitude %v is not normalized", lead.Hours, p.Lon)
+				}
+				if d := geo.Distance(geo.Point{Lat: p.Lat, Lon: p.Lon}, tt.centers[i]); d > 0.1 {
+					t.Errorf("+%dh: center %.4f,%.4f, want %v (%.3f km off)", lead.Hours, p.Lat, p.Lon, tt.centers[i], d)
+				}
+			}
+
+			checkCone(t, f, origin, tt.centers)
+		})
+	}
+}
+
+// Конус выпуклый, обходится против часовой стрелки, не разрывается на антимеридиане
+// и накрывает начало трека и все круги неопределённости
+func checkCone(t *testing.T, f *models.StormForecast, origin geo.Point, centers []geo.Point) {
+	t.Helper()
+	cone := f.Cone
+	if len(cone) < 3 {
+		t.Fatalf("cone has %d vertices", len(cone))
+	}
+	for i := range cone {
+		a, b, c := cone[i], cone[(i+1)%len(cone)], cone[(i+2)%len(cone)]
+		if cross := (b.Lon-a.Lon)*(c.Lat-a.Lat) - (b.Lat-a.Lat)*(c.Lon-a.Lon); cross <= 0 {
+			t.Fatalf("cone is not convex counter-clockwise at vertex %d: %v %v %v", i+1, a, b, c)
+		}
+		if math.Abs(cone[i].Lon-origin.Lon) >= 180 {
+			t.Fatalf("cone vertex %v is more than 180 degrees from the origin", cone[i])
+		}
+	}
+
+	// Начало трека — вершина конуса у движущегося шторма и внутренняя точка у стоящего
+	apex := false
+	for _, v := range cone {
+		apex = apex || v == origin
+	}
+	if !apex && !geo.InPolygon(origin, cone) {
+		t.Errorf("cone does not cover the origin %v", origin)
+	}
+	for i, center := range centers {
+		center.Lon = unwrap(center.Lon, origin.Lon)
+		if !geo.InPolygon(center, cone) {
+			t.Errorf("+%dh: cone does not cover the center %v", Leads[i].Hours, center)
+		}
+		// Точки чуть внутри круга неопределённости лежат в конусе
+		for _, p := range geo.Circle(center, Leads[i].RadiusKm*0.98, 36) {
+			p.Lon = unwrap(p.Lon, origin.Lon)
+			if !geo.InPolygon(p, cone) {
+				t.Errorf("+%dh: cone does not cover %v of the uncertainty circle", Leads[i].Hours, p)
+				break
+			}
+		}
+	}
+
+	// Оболочка, а не описанный прямоугольник: каждая вершина — начало трека или точка одного из кругов
+	for _, v := range cone {
+		if v == origin {
+			continue
+		}
+		onCircle := false
+		for i, center := range centers {
+			center.Lon = unwrap(center.Lon, origin.Lon)
+			onCircle = onCircle || math.Abs(geo.Distance(v, center)-Leads[i].RadiusKm) < 0.01
+		}
+		if !onCircle {
+			t.Errorf("cone vertex %v is neither the origin nor on an uncertainty circle", v)
+		}
+	}
+}
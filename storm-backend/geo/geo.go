@@ -0,0 +1,138 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+const EarthRadiusKm = 6371.0 // Средний радиус Земли
+
+// Точка в градусах
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// Прямоугольник широт и долгот; для фигур через антимеридиан MinLon = -180 и MaxLon = 180
+type Bounds struct {
+	MinLat, MaxLat float64
+	MinLon, MaxLon float64
+}
+
+// Расстояние по большому кругу (формула гаверсинусов), км
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLon := radians(b.Lon - a.Lon)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Начальный азимут из a на b по большому кругу, градусы от севера по часовой стрелке [0, 360)
+func Bearing(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLon := radians(b.Lon - a.Lon)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// Точка в distanceKm от start по большому кругу с начальным азимутом bearing
+func Destination(start Point, bearing, distanceKm float64) Point {
+	lat1, lon1 := radians(start.Lat), radians(start.Lon)
+	theta, delta := radians(bearing), distanceKm/EarthRadiusKm
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lon2 := lon1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2))
+	return Point{Lat: degrees(lat2), Lon: NormalizeLon(degrees(lon2))}
+}
+
+// Долгота в диапазоне [-180, 180)
+func NormalizeLon(lon float64) float64 {
+	return math.Mod(math.Mod(lon+180, 360)+360, 360) - 180
+}
+
+// Попадание точки в многоугольник (вершины без повтора первой) по чётности пересечений луча.
+// Рёбра считаются отрезками на плоскости широт и долгот, что достаточно для зон в сотни километров
+func InPolygon(p Point, ring []Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lon < (b.Lon-a.Lon)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Прямоугольник, описанный вокруг круга радиусом radiusKm
+func CircleBounds(center Point, radiusKm float64) Bounds {
+	dLat := degrees(radiusKm / EarthRadiusKm)
+	b := Bounds{MinLat: center.Lat - dLat, MaxLat: center.Lat + dLat}
+	if b.MinLat <= -90 || b.MaxLat >= 90 { // Круг накрывает полюс — подходит любая долгота
+		b.MinLat, b.MaxLat = math.Max(b.MinLat, -90), math.Min(b.MaxLat, 90)
+		b.MinLon, b.MaxLon = -180, 180
+		return b
+	}
+	dLon := degrees(math.Asin(math.Min(1, math.Sin(radiusKm/EarthRadiusKm)/math.Cos(radians(center.Lat)))))
+	b.MinLon, b.MaxLon = center.Lon-dLon, center.Lon+dLon
+	if b.MinLon < -180 || b.MaxLon > 180 {
+		b.MinLon, b.MaxLon = -180, 180
+	}
+	return b
+}
+
+// Прямоугольник, описанный вокруг многоугольника
+func PolygonBounds(ring []Point) Bounds {
+	b := Bounds{MinLat: 90, MaxLat: -90, MinLon: 180, MaxLon: -180}
+	for _, p := range ring {
+		b.MinLat, b.MaxLat = math.Min(b.MinLat, p.Lat), math.Max(b.MaxLat, p.Lat)
+		b.MinLon, b.MaxLon = math.Min(b.MinLon, p.Lon), math.Max(b.MaxLon, p.Lon)
+	}
+	return b
+}
+
+// Окружность радиусом radiusKm, приближённая n вершинами
+func Circle(center Point, radiusKm float64, n int) []Point {
+	ring := make([]Point, 0, n)
+	for i := 0; i < n; i++ {
+		ring = append(ring, Destination(center, 360*float64(i)/float64(n), radiusKm))
+	}
+	return ring
+}
+
+// Выпуклая оболочка точек на плоскости широт и долгот (алгоритм Эндрю) против часовой стрелки,
+// без повтора первой вершины. Точки должны лежать по одну сторону антимеридиана
+func ConvexHull(points []Point) []Point {
+	if len(points) < 3 {
+		return append([]Point(nil), points...)
+	}
+	sorted := append([]Point(nil), points...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Lon != sorted[j].Lon {
+			return sorted[i].Lon < sorted[j].Lon
+		}
+		return sorted[i].Lat < sorted[j].Lat
+	})
+	cross := func(o, a, b Point) float64 {
+		return (a.Lon-o.Lon)*(b.Lat-o.Lat) - (a.Lat-o.Lat)*(b.Lon-o.Lon)
+	}
+	hull := make([]Point, 0, 2*len(sorted))
+	for _, p := range sorted { // Нижняя цепь
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	for i, lower := len(sorted)-2, len(hull)+1; i >= 0; i-- { // Верхняя цепь
+		p := sorted[i]
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	return hull[:len(hull)-1]
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
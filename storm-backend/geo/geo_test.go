@@ -0,0 +1,100 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+const kmPerDegree = 2 * math.Pi * EarthRadiusKm / 360 // Градус большого круга, ≈111.195 км
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Point
+		want float64
+	}{
+		{"same point", Point{25.8, -80.2}, Point{25.8, -80.2}, 0},
+		{"one degree of the equator", Point{0, 0}, Point{0, 1}, kmPerDegree},
+		{"one degree of a meridian", Point{10, 30}, Point{11, 30}, kmPerDegree},
+		{"equator to pole", Point{0, 0}, Point{90, 0}, 90 * kmPerDegree},
+		{"antipodes", Point{0, 0}, Point{0, 180}, 180 * kmPerDegree},
+		{"across the antimeridian", Point{0, 179}, Point{0, -179}, 2 * kmPerDegree},
+		// Сдвиг по параллели 60° короче, чем по экватору: cos(60°)·Δλ для малых Δλ
+		{"along the 60th parallel", Point{60, 0}, Point{60, 1}, 55.596},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distance(tt.a, tt.b); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("Distance = %.3f, want %.3f", got, tt.want)
+			}
+			if got := Distance(tt.b, tt.a); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("reverse Distance = %.3f, want %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBearing(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Point
+		want float64
+	}{
+		{"north", Point{0, 0}, Point{1, 0}, 0},
+		{"east", Point{0, 0}, Point{0, 1}, 90},
+		{"south", Point{0, 0}, Point{-1, 0}, 180},
+		{"west", Point{0, 0}, Point{0, -1}, 270},
+		{"east across the antimeridian", Point{0, 179}, Point{0, -179}, 90},
+		{"north-east on the equator", Point{0, 0}, Point{1, 1}, 44.996},
+		// По большому кругу вдоль 45° с.ш. путь сначала уходит к северу: tg θ = 1/sin 45°
+		{"great circle heads poleward", Point{45, 0}, Point{45, 90}, 54.736},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bearing(tt.a, tt.b); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("Bearing = %.3f, want %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDestination(t *testing.T) {
+	tests := []struct {
+		start    Point
+		bearing  float64
+		distance float64
+		want     Point
+	}{
+		{Point{0, 0}, 90, kmPerDegree, Point{0, 1}},
+		{Point{0, 0}, 0, 90 * kmPerDegree, Point{90, 0}},
+		{Point{0, 179.5}, 90, kmPerDegree, Point{0, -179.5}},
+		{Point{25.8, -80.2}, 123, 0, Point{25.8, -80.2}},
+	}
+	for _, tt := range tests {
+		got := Destination(tt.start, tt.bearing, tt.distance)
+		if math.Abs(got.Lat-tt.want.Lat) > 1e-6 || math.Abs(got.Lon-tt.want.Lon) > 1e-6 {
+			t.Errorf("Destination(%v, %v, %v) = %v, want %v", tt.start, tt.bearing, tt.distance, got, tt.want)
+		}
+	}
+
+	// Обратная проверка: до полученной точки ровно distance по исходному азимуту
+	start := Point{25.8, -80.2}
+	for _, bearing := range []float64{0, 37, 90, 181, 300} {
+		end := Destination(start, bearing, 500)
+		if d := Distance(start, end); math.Abs(d-500) > 1e-6 {
+			t.Errorf("bearing %v: distance = %v", bearing, d)
+		}
+		if b := Bearing(start, end); math.Abs(b-bearing) > 1e-6 {
+			t.Errorf("bearing %v: got %v", bearing, b)
+		}
+	}
+}
+
+func TestNormalizeLon(t *testing.T) {
+	tests := map[float64]float64{0: 0, 179.5: 179.5, 180: -180, 190: -170, -190: 170, 540: -180, -360: 0}
+	for lon, want := range tests {
+		if got := NormalizeLon(lon); math.Abs(got-want) > 1e-9 {
+			t.Errorf("NormalizeLon(%v) = %v, want %v", lon, got, want)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const channelPrefix = "storm_updates:" // Каналы, в которые weather-worker публикует обновления
+
+// Хаб держит одну подписку Redis на регион и раздаёт сообщения всем потокам процесса
+type Hub struct {
+	rdb    *redis.Client
+	prefix string // Префикс каналов Redis, за которым следует регион
+	buffer int    // Ёмкость очереди одного подписчика
+
+	mu      sync.Mutex
+	pubsub  *redis.PubSub                       // Общее соединение pub/sub, создаётся при первой подписке
+	regions map[string]map[*Subscriber]struct{} // Подписчики по регионам
+	closed  bool
+}
+
+// Подписчик хаба с ограниченной очередью сообщений
+type Subscriber struct {
+	hub     *Hub
+	region  string
+	ch      chan string
+	dropped atomic.Int64
+	once    sync.Once
+}
+
+// Создание хаба обновлений weather-worker; buffer — ёмкость очереди каждого подписчика
+func New(rdb *redis.Client, buffer int) *Hub {
+	return NewWithPrefix(rdb, channelPrefix, buffer)
+}
+
+// Создание хаба для каналов prefix+<регион>, например событий штормов
+func NewWithPrefix(rdb *redis.Client, prefix string, buffer int) *Hub {
+	if buffer < 1 {
+		buffer = 1
+	}
+	return &Hub{
+		rdb:     rdb,
+		prefix:  prefix,
+		buffer:  buffer,
+		regions: make(map[string]map[*Subscriber]struct{}),
+	}
+}
+
+// Подписка на обновления региона; подписка в Redis создаётся только для первого подписчика
+func (h *Hub) Subscribe(ctx context.Context, region string) (*Subscriber, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil, fmt.Errorf("hub is closed")
+	}
+
+	if h.regions[region] == nil {
+		channel := h.prefix + region
+		if h.pubsub == nil {
+			pubsub := h.rdb.Subscribe(context.Background()) // Соединение живёт дольше потока, открывшего его
+			if err := pubsub.Subscribe(ctx, channel); err != nil {
+				_ = pubsub.Close()
+				return nil, fmt.Errorf("failed to subscribe to redis channel %s: %w", channel, err)
+			}
+			h.pubsub = pubsub
+			go h.dispatch(h.pubsub)
+		} else if err := h.pubsub.Subscribe(ctx, channel); err != nil {
+			return nil, fmt.Errorf("failed to subscribe to redis channel %s: %w", channel, err)
+		}
+		h.regions[region] = make(map[*Subscriber]struct{})
+		log.Info().Str("region", region).Msg("Hub subscribed to region channel")
+	}
+
+	sub := &Subscriber{hub: h, region: region, ch: make(chan string, h.buffer)}
+	h.regions[region][sub] = struct{}{}
+	return sub, nil
+}
+
+// Канал сообщений подписчика; закрывается при Close или остановке хаба
+func (s *Subscriber) Updates() <-chan string {
+	return s.ch
+}
+
+// Число сообщений, выброшенных из-за медленного чтения
+func (s *Subscriber) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Отписка; последняя отписка от региона снимает подписку в Redis
+func (s *Subscriber) Close() {
+	s.once.Do(func() {
+		h := s.hub
+		if !h.remove(s) {
+			return
+		}
+
+		// Запрос в Redis идёт без блокировки, чтобы не задерживать dispatch и другие подписки
+		channel := h.prefix + s.region
+		if err := h.pubsub.Unsubscribe(context.Background(), channel); err != nil {
+			log.Error().Err(err).Str("region", s.region).Msg("Failed to unsubscribe from region channel")
+			return
+		}
+		log.Info().Str("region", s.region).Msg("Hub unsubscribed from region channel")
+
+		// Новый подписчик мог прийти, пока шла отписка, и его SUBSCRIBE мог опередить наш UNSUBSCRIBE
+		h.mu.Lock()
+		_, back := h.regions[s.region]
+		back = back && !h.closed
+		h.mu.Unlock()
+		if back {
+			if err := h.pubsub.Subscribe(context.Background(), channel); err != nil {
+				log.Error().Err(err).Str("region", s.region).Msg("Failed to resubscribe to region channel")
+			}
+		}
+	})
+}
+
+// Удаление подписчика из хаба; true, если это был последний подписчик региона
+func (h *Hub) remove(s *Subscriber) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.regions[s.region]
+	if !ok {
+		return false // Хаб уже закрыт и закрыл канал сам
+	}
+	delete(subs, s)
+	close(s.ch)
+	if len(subs) > 0 {
+		return false
+	}
+	delete(h.regions, s.region)
+	return true
+}
+
+// Число локальных подписчиков по регионам
+func (h *Hub) Counts() map[string]int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	counts := make(map[string]int, len(h.regions))
+	for region, subs := range h.regions {
+		counts[region] = len(subs)
+	}
+	return counts
+}
+
+// Остановка хаба: закрытие соединения pub/sub и всех каналов подписчиков
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil
+	}
+	h.closed = true
+
+	for region, subs := range h.regions {
+		for sub := range subs {
+			close(sub.ch)
+		}
+		delete(h.regions, region)
+	}
+	if h.pubsub == nil {
+		return nil
+	}
+	return h.pubsub.Close()
+}
+
+// Чтение общего соединения pub/sub и раздача сообщений подписчикам региона
+func (h *Hub) dispatch(pubsub *redis.PubSub) {
+	for msg := range pubsub.Channel() {
+		region := strings.TrimPrefix(msg.Channel, h.prefix)
+
+		h.mu.Lock()
+		for sub := range h.regions[region] {
+			sub.deliver(msg.Payload)
+		}
+		h.mu.Unlock()
+	}
+}
+
+// Неблокирующая доставка: при переполненной очереди выбрасывается самое старое сообщение
+func (s *Subscriber) deliver(payload string) {
+	for {
+		select {
+		case s.ch <- payload:
+			return
+		default:
+		}
+		select {
+		case <-s.ch:
+			s.dropped.Add(1)
+		default:
+		}
+	}
+}
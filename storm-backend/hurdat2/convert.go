@@ -0,0 +1,115 @@
+package hurdat2
+
+import (
+	"math"
+	"strings"
+
+	"Storm-Hunt/storm-backend/classify"
+	"Storm-Hunt/storm-backend/models"
+)
+
+const (
+	kmPerNauticalMile = 1.852 // Узлы в км/ч и морские мили в км
+	trackPoint        = "best-track"
+)
+
+// Регионы каталога по бассейнам HURDAT2, если при импорте регион не задан явно
+var BasinRegions = map[string]string{
+	"AL": "Atlantic",
+	"EP": "Pacific",
+	"CP": "Pacific",
+}
+
+// Идентификатор шторма в хранилище, например hurdat2-al092011
+func StormID(record Record) string {
+	return models.StormSourceHURDAT2 + "-" + strings.ToLower(record.ID)
+}
+
+// Перевод записи HURDAT2 в закрытый шторм и его трек. Пик и классификация считаются
+// так же, как у обнаруженных штормов; фиксации без ветра в пик не попадают
+func Convert(record Record, region string, thresholds *classify.Thresholds) (*models.Storm, []models.TrackPoint) {
+	first, last := record.Fixes[0], record.Fixes[len(record.Fixes)-1]
+	ended := last.Time
+	storm := &models.Storm{
+		ID:        StormID(record),
+		Region:    region,
+		Source:    models.StormSourceHURDAT2,
+		Status:    models.StormClosed,
+		StartedAt: first.Time,
+		EndedAt:   &ended,
+		UpdatedAt: last.Time,
+		Lat:       float32(last.Lat),
+		Lon:       float32(last.Lon),
+		PeakAt:    first.Time,
+	}
+	if record.Name != "" && !strings.EqualFold(record.Name, "UNNAMED") {
+		storm.Name = record.Name
+	}
+
+	track := make([]models.TrackPoint, 0, len(record.Fixes))
+	peak := -1
+	for i, fix := range record.Fixes {
+		point := models.TrackPoint{
+			ObservedAt: fix.Time,
+			Point:      trackPoint,
+			Lat:        float32(fix.Lat),
+			Lon:        float32(fix.Lon),
+			Record:     fix.Record,
+			Status:     fix.Status,
+			WindRadii:  windRadii(fix),
+		}
+		if fix.WindKt != missingWind {
+			point.WindKmH = knots(fix.WindKt)
+		}
+		if fix.Pressure != missingPressure {
+			point.Pressure = float32(fix.Pressure)
+			if storm.MinPressure == 0 || point.Pressure < storm.MinPressure {
+				storm.MinPressure = point.Pressure
+			}
+		}
+		if fix.WindKt != missingWind && (peak < 0 || point.WindKmH > track[peak].WindKmH) {
+			peak = i
+		}
+		track = append(track, point)
+	}
+
+	storm.WindKmH = track[len(track)-1].WindKmH
+	if peak >= 0 {
+		p := track[peak]
+		storm.PeakWindKmH, storm.PeakAt = p.WindKmH, p.ObservedAt
+		c := thresholds.Classify(p.WindKmH, p.Lat)
+		storm.Beaufort, storm.Category, storm.Severity = c.Beaufort, c.Category, int32(c.Severity)
+	}
+	return storm, track
+}
+
+// Радиусы в км; nil, если ни одного радиуса нет (все записи до 2004 года)
+func windRadii(fix Fix) *models.WindRadii {
+	var (
+		radii models.WindRadii
+		any   bool
+	)
+	convert := func(dst *[4]float32, src [4]int) {
+		for i, nm := range src {
+			if nm != missingRadius && nm > 0 {
+				dst[i] = float32(math.Round(float64(nm)*kmPerNauticalMile*10) / 10)
+				any = true
+			}
+		}
+	}
+	convert(&radii.Kt34, fix.Radii34)
+	convert(&radii.Kt50, fix.Radii50)
+	convert(&radii.Kt64, fix.Radii64)
+	if fix.MaxWindRadius != missingRadius && fix.MaxWindRadius > 0 {
+		radii.MaxWindRadius = float32(math.Round(float64(fix.MaxWindRadius)*kmPerNauticalMile*10) / 10)
+		any = true
+	}
+	if !any {
+		return nil
+	}
+	return &radii
+}
+
+func knots(kt int) float32 {
+	return float32(math.Round(float64(kt)*kmPerNauticalMile*10) / 10)
+}
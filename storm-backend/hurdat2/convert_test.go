@@ -0,0 +1,111 @@
+package hurdat2
+
+import (
+	"testing"
+	"time"
+
+	"Storm-Hunt/storm-backend/classify"
+	"Storm-Hunt/storm-backend/models"
+)
+
+func TestConvert(t *testing.T) {
+	records, err := parseFile(t, "sample.txt")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	thresholds := classify.Default()
+
+	storm, track := Convert(records[1], "Atlantic", thresholds)
+	if storm.ID != "hurdat2-al092011" || storm.Name != "IRENE" || storm.Source != models.StormSourceHURDAT2 || storm.Region != "Atlantic" {
+		t.Errorf("storm = %s %q %s %s", storm.ID, storm.Name, storm.Source, storm.Region)
+	}
+	if storm.Status != models.StormClosed || storm.EndedAt == nil || !storm.EndedAt.Equal(time.Date(2011, 8, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("status = %s, ended at %v", storm.Status, storm.EndedAt)
+	}
+	if !storm.StartedAt.Equal(time.Date(2011, 8, 21, 0, 0, 0, 0, time.UTC)) || storm.Lat != 52 || storm.Lon != -65 {
+		t.Errorf("started at %v, last position %v, %v", storm.StartedAt, storm.Lat, storm.Lon)
+	}
+	// Пик — 75 узлов 27 августа; фиксация без ветра (-99) в пик не попадает
+	if storm.PeakWindKmH != 138.9 || !storm.PeakAt.Equal(time.Date(2011, 8, 27, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("peak = %v at %v", storm.PeakWindKmH, storm.PeakAt)
+	}
+	if storm.MinPressure != 952 || storm.WindKmH != 0 {
+		t.Errorf("min pressure = %v, last wind = %v", storm.MinPressure, storm.WindKmH)
+	}
+	c := thresholds.Classify(138.9, 34.7)
+	if storm.Beaufort != c.Beaufort || storm.Category != c.Category || storm.Severity != int32(c.Severity) {
+		t.Errorf("classification = %d %d %d, want %+v", storm.Beaufort, storm.Category, storm.Severity, c)
+	}
+
+	if len(track) != 3 {
+		t.Fatalf("got %d track points, want 3", len(track))
+	}
+	if p := track[0]; p.WindKmH != 83.3 || p.Pressure != 1006 || p.Status != "TS" || p.Record != "" || p.Point != "best-track" {
+		t.Errorf("first fix = %+v", p)
+	}
+	if p := track[2]; p.WindKmH != 0 || p.Pressure != 0 || p.WindRadii != nil {
+		t.Errorf("missing values = %+v", p)
+	}
+	radii := track[0].WindRadii
+	if radii == nil || radii.Kt34 != [4]float32{194.5, 0, 0, 83.3} || radii.Kt50 != [4]float32{} || radii.MaxWindRadius != 0 {
+		t.Errorf("wind radii = %+v", radii)
+	}
+	if p := track[1]; p.Record != "L" || p.WindRadii.Kt64 != [4]float32{111.1, 92.6, 55.6, 55.6} {
+		t.Errorf("landfall fix = %+v, radii %+v", p, p.WindRadii)
+	}
+}
+
+func TestConvertMissingValues(t *testing.T) {
+	records, err := parseFile(t, "sample.txt")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	// Безымянный шторм без давления и радиусов
+	storm, track := Convert(records[0], "Atlantic", classify.Default())
+	if storm.ID != "hurdat2-al011851" || storm.Name != "" {
+		t.Errorf("storm = %s %q", storm.ID, storm.Name)
+	}
+	if storm.MinPressure != 0 || storm.PeakWindKmH != 148.2 {
+		t.Errorf("min pressure = %v, peak = %v", storm.MinPressure, storm.PeakWindKmH)
+	}
+	for _, p := range track {
+		if p.Pressure != 0 || p.WindRadii != nil {
+			t.Errorf("fix %v = %+v", p.ObservedAt, p)
+		}
+	}
+
+	// Радиус максимального ветра из формата 2022 года; -999 у второй фиксации
+	_, track = Convert(records[2], "Pacific", classify.Default())
+	if track[0].WindRadii.MaxWindRadius != 18.5 || track[1].WindRadii.MaxWindRadius != 0 {
+		t.Errorf("max wind radius = %v, %v", track[0].WindRadii.MaxWindRadius, track[1].WindRadii.MaxWindRadius)
+	}
+
+	// Долгота восточного полушария после перехода линии перемены дат
+	_, track = Convert(records[3], "Pacific", classify.Default())
+	if track[0].Lon != -177.9 || track[1].Lon != 179.5 {
+		t.Errorf("longitudes = %v, %v", track[0].Lon, track[1].Lon)
+	}
+}
+
+func TestConvertNoWind(t *testing.T) {
+	record := Record{ID: "AL011900", Basin: "AL", Name: "UNNAMED", Fixes: []Fix{
+		{Time: time.Date(1900, 9, 1, 0, 0, 0, 0, time.UTC), Status: "LO", Lat: 20, Lon: -70, WindKt: missingWind, Pressure: missingPressure, MaxWindRadius: missingRadius},
+	}}
+	storm, track := Convert(record, "Atlantic", classify.Default())
+	if storm.PeakWindKmH != 0 || !storm.PeakAt.Equal(record.Fixes[0].Time) || storm.Beaufort != 0 || storm.Category != 0 {
+		t.Errorf("storm without wind = %+v", storm)
+	}
+	if len(track) != 1 || track[0].WindKmH != 0 {
+		t.Errorf("track = %+v", track)
+	}
+}
+
+func TestStormID(t *testing.T) {
+	if id := StormID(Record{ID: "EP182023"}); id != "hurdat2-ep182023" {
+		t.Errorf("StormID = %s", id)
+	}
+	if BasinRegions["AL"] != "Atlantic" || BasinRegions["EP"] != "Pacific" || BasinRegions["CP"] != "Pacific" {
+		t.Errorf("BasinRegions = %v", BasinRegions)
+	}
+}
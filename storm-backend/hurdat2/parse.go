@@ -0,0 +1,176 @@
+package hurdat2
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Отсутствующие значения в HURDAT2
+const (
+	missingWind     = -99
+	missingPressure = -999
+	missingRadius   = -999
+)
+
+// Шторм из HURDAT2: строка заголовка и фиксации best track за ней
+type Record struct {
+	ID     string // Идентификатор ATCF, например AL092011
+	Basin  string // AL — Атлантика, EP — северо-восток Тихого океана, CP — центральная часть Тихого океана
+	Number int    // Номер шторма в сезоне
+	Year   int
+	Name   string // UNNAMED у безымянных
+	Fixes  []Fix
+}
+
+// Фиксация best track, обычно каждые 6 часов, плюс отдельные записи о выходе на сушу и т.п.
+type Fix struct {
+	Time     time.Time
+	Record   string // L — выход на сушу, P — минимум давления, I — пик интенсивности и т.д.; пусто у синоптических сроков
+	Status   string // TD, TS, HU, EX, SD, SS, LO, WV, DB
+	Lat, Lon float64
+	WindKt   int // Максимальный устойчивый ветер, узлы; -99 — нет данных
+	Pressure int // Минимальное давление, гПа; -999 — нет данных
+
+	// Радиусы ветра 34, 50 и 64 узла по квадрантам NE, SE, SW, NW, морские мили; -999 — нет данных
+	Radii34, Radii50, Radii64 [4]int
+	MaxWindRadius             int // Радиус максимального ветра, морские мили; -999 — нет данных или старый формат
+}
+
+// Разбор файла HURDAT2 (Атлантика или северо-восток и центр Тихого океана).
+// Ошибка указывает номер строки; частично прочитанный файл не возвращается
+func Parse(r io.Reader) ([]Record, error) {
+	scanner := bufio.NewScanner(r)
+	var (
+		records []Record
+		current *Record
+		pending int // Сколько строк фиксаций ещё ждёт текущий заголовок
+		line    int
+	)
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		fields := splitFields(text)
+
+		if pending == 0 {
+			record, count, err := parseHeader(fields)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			records = append(records, record)
+			current, pending = &records[len(records)-1], count
+			continue
+		}
+
+		fix, err := parseFix(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", line, current.ID, err)
+		}
+		current.Fixes = append(current.Fixes, fix)
+		pending--
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if pending > 0 {
+		return nil, fmt.Errorf("%s: file ends %d fix(es) early", current.ID, pending)
+	}
+	return records, nil
+}
+
+// Заголовок: "AL092011,              IRENE,     39,"
+func parseHeader(fields []string) (Record, int, error) {
+	if len(fields) < 3 {
+		return Record{}, 0, fmt.Errorf("expected header <id>, <name>, <entries>, got %d field(s)", len(fields))
+	}
+	id := strings.ToUpper(fields[0])
+	if len(id) != 8 {
+		return Record{}, 0, fmt.Errorf("invalid storm id %q", fields[0])
+	}
+	number, err1 := strconv.Atoi(id[2:4])
+	year, err2 := strconv.Atoi(id[4:])
+	if err1 != nil || err2 != nil || id[0] < 'A' || id[0] > 'Z' || id[1] < 'A' || id[1] > 'Z' {
+		return Record{}, 0, fmt.Errorf("invalid storm id %q", fields[0])
+	}
+	count, err := strconv.Atoi(fields[2])
+	if err != nil || count < 1 {
+		return Record{}, 0, fmt.Errorf("invalid number of entries %q", fields[2])
+	}
+	return Record{ID: id, Basin: id[:2], Number: number, Year: year, Name: fields[1]}, count, nil
+}
+
+// Фиксация: дата, время, идентификатор записи, статус, широта, долгота, ветер, давление,
+// 12 радиусов ветра и, с пересмотра 2022 года, радиус максимального ветра
+func parseFix(fields []string) (Fix, error) {
+	if len(fields) < 20 {
+		return Fix{}, fmt.Errorf("expected at least 20 fields in a fix, got %d", len(fields))
+	}
+	at, err := time.Parse("20060102 1504", fields[0]+" "+fields[1])
+	if err != nil {
+		return Fix{}, fmt.Errorf("invalid date and time %q %q", fields[0], fields[1])
+	}
+	fix := Fix{Time: at.UTC(), Record: fields[2], Status: fields[3], MaxWindRadius: missingRadius}
+	if fix.Lat, err = parseCoordinate(fields[4], 'N', 'S', 90); err != nil {
+		return Fix{}, err
+	}
+	if fix.Lon, err = parseCoordinate(fields[5], 'E', 'W', 180); err != nil {
+		return Fix{}, err
+	}
+
+	ints := make([]int, 0, 15)
+	for _, raw := range fields[6:] {
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return Fix{}, fmt.Errorf("invalid number %q", raw)
+		}
+		ints = append(ints, n)
+	}
+	if len(ints) < 14 {
+		return Fix{}, fmt.Errorf("expected wind, pressure and 12 wind radii, got %d value(s)", len(ints))
+	}
+	fix.WindKt, fix.Pressure = ints[0], ints[1]
+	copy(fix.Radii34[:], ints[2:6])
+	copy(fix.Radii50[:], ints[6:10])
+	copy(fix.Radii64[:], ints[10:14])
+	if len(ints) > 14 {
+		fix.MaxWindRadius = ints[14]
+	}
+	return fix, nil
+}
+
+// Координата вида "28.0N" или "94.8W"
+func parseCoordinate(raw string, positive, negative byte, limit float64) (float64, error) {
+	if len(raw) < 2 {
+		return 0, fmt.Errorf("invalid coordinate %q", raw)
+	}
+	value, err := strconv.ParseFloat(raw[:len(raw)-1], 64)
+	if err != nil || value < 0 || value > limit {
+		return 0, fmt.Errorf("invalid coordinate %q", raw)
+	}
+	switch raw[len(raw)-1] {
+	case positive:
+	case negative:
+		value = -value
+	default:
+		return 0, fmt.Errorf("invalid coordinate %q", raw)
+	}
+	return value, nil
+}
+
+// Поля строки через запятую без пробелов; завершающая запятая не даёт лишнего поля
+func splitFields(text string) []string {
+	fields := strings.Split(strings.TrimSuffix(text, ","), ",")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	return fields
+}
@@ -0,0 +1,140 @@
+package hurdat2
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func parseFile(t *testing.T, name string) ([]Record, error) {
+	t.Helper()
+	file, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	return Parse(file)
+}
+
+func TestParseSample(t *testing.T) {
+	records, err := parseFile(t, "sample.txt")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("got %d records, want 4", len(records))
+	}
+
+	headers := []struct {
+		id, basin, name string
+		number, year    int
+		fixes           int
+	}{
+		{"AL011851", "AL", "UNNAMED", 1, 1851, 3},
+		{"AL092011", "AL", "IRENE", 9, 2011, 3},
+		{"EP182023", "EP", "OTIS", 18, 2023, 2},
+		{"CP032015", "CP", "KILO", 3, 2015, 2},
+	}
+	for i, want := range headers {
+		r := records[i]
+		if r.ID != want.id || r.Basin != want.basin || r.Name != want.name || r.Number != want.number || r.Year != want.year {
+			t.Errorf("record %d = %s %s %s %d %d, want %+v", i, r.ID, r.Basin, r.Name, r.Number, r.Year, want)
+		}
+		if len(r.Fixes) != want.fixes {
+			t.Errorf("%s: got %d fixes, want %d", r.ID, len(r.Fixes), want.fixes)
+		}
+	}
+
+	landfall := records[0].Fixes[2]
+	if want := time.Date(1851, 6, 25, 21, 0, 0, 0, time.UTC); !landfall.Time.Equal(want) {
+		t.Errorf("time = %v, want %v", landfall.Time, want)
+	}
+	if landfall.Record != "L" || landfall.Status != "TS" || landfall.WindKt != 60 {
+		t.Errorf("landfall fix = %+v", landfall)
+	}
+	if landfall.Pressure != missingPressure || landfall.Radii34[0] != missingRadius || landfall.MaxWindRadius != missingRadius {
+		t.Errorf("missing values not kept: %+v", landfall)
+	}
+
+	irene := records[1].Fixes
+	if irene[1].Radii34 != [4]int{260, 230, 130, 150} || irene[1].Radii50 != [4]int{130, 100, 60, 70} || irene[1].Radii64 != [4]int{60, 50, 30, 30} {
+		t.Errorf("wind radii = %v %v %v", irene[1].Radii34, irene[1].Radii50, irene[1].Radii64)
+	}
+	if irene[2].WindKt != missingWind || irene[2].Pressure != missingPressure {
+		t.Errorf("missing wind and pressure = %d %d", irene[2].WindKt, irene[2].Pressure)
+	}
+
+	// Формат с пересмотра 2022 года: 21-е поле — радиус максимального ветра
+	otis := records[2].Fixes
+	if otis[0].MaxWindRadius != 10 || otis[1].MaxWindRadius != missingRadius {
+		t.Errorf("max wind radius = %d, %d", otis[0].MaxWindRadius, otis[1].MaxWindRadius)
+	}
+	if want := time.Date(2023, 10, 25, 6, 45, 0, 0, time.UTC); !otis[1].Time.Equal(want) {
+		t.Errorf("time = %v, want %v", otis[1].Time, want)
+	}
+}
+
+func TestParseHemispheres(t *testing.T) {
+	records, err := parseFile(t, "sample.txt")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	tests := []struct {
+		fix      Fix
+		lat, lon float64
+	}{
+		{records[0].Fixes[0], 28.0, -94.8},
+		{records[3].Fixes[0], 19.6, -177.9},
+		{records[3].Fixes[1], 21.0, 179.5},
+	}
+	for _, tt := range tests {
+		if tt.fix.Lat != tt.lat || tt.fix.Lon != tt.lon {
+			t.Errorf("%v: got %v, %v, want %v, %v", tt.fix.Time, tt.fix.Lat, tt.fix.Lon, tt.lat, tt.lon)
+		}
+	}
+
+	south, err := Parse(strings.NewReader("SH012020, TEST, 1,\n20200101, 0000,  , TS, 12.5S,  45.0E,  40, 1000\n"))
+	if err == nil {
+		t.Fatalf("short fix parsed: %+v", south)
+	}
+	south, err = Parse(strings.NewReader("SH012020, TEST, 1,\n20200101, 0000,  , TS, 12.5S,  45.0E,  40, 1000" + strings.Repeat(", -999", 12) + ",\n"))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if fix := south[0].Fixes[0]; fix.Lat != -12.5 || fix.Lon != 45 {
+		t.Errorf("southern fix = %v, %v", fix.Lat, fix.Lon)
+	}
+}
+
+func TestParseTruncated(t *testing.T) {
+	_, err := parseFile(t, "truncated.txt")
+	if err == nil || !strings.Contains(err.Error(), "AL011851: file ends 1 fix(es) early") {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	fix := "18510625, 0000,  , HU, 28.0N,  94.8W,  80, -999" + strings.Repeat(", -999", 12) + ","
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"bad header", "AL0118,  UNNAMED, 1,\n" + fix, "line 1: invalid storm id"},
+		{"bad count", "AL011851,  UNNAMED, x,\n" + fix, "line 1: invalid number of entries"},
+		{"bad hemisphere", "AL011851,  UNNAMED, 2,\n" + fix + "\n" + strings.Replace(fix, "94.8W", "94.8X", 1), `line 3: AL011851: invalid coordinate "94.8X"`},
+		{"latitude out of range", "AL011851,  UNNAMED, 1,\n\n" + strings.Replace(fix, "28.0N", "91.0N", 1), `line 3: AL011851: invalid coordinate "91.0N"`},
+		{"bad date", "AL011851,  UNNAMED, 1,\n" + strings.Replace(fix, "18510625", "18511325", 1), "line 2: AL011851: invalid date and time"},
+		{"bad number", "AL011851,  UNNAMED, 1,\n" + strings.Replace(fix, " 80,", " eighty,", 1), `line 2: AL011851: invalid number "eighty"`},
+		{"missing radii", "AL011851,  UNNAMED, 1,\n18510625, 0000,  , HU, 28.0N,  94.8W,  80, -999, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, ,\n", "line 2: AL011851: expected wind, pressure and 12 wind radii"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
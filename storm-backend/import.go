@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"Storm-Hunt/storm-backend/classify"
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/hurdat2"
+)
+
+const importUsage = `usage: storm-backend import <format> [options] <file>
+
+formats:
+  hurdat2     NOAA HURDAT2 best track (Atlantic or Northeast/North Central Pacific)
+
+options:
+  -region ID  catalogue region for all storms in the file
+              (default: Atlantic for AL storms, Pacific for EP and CP storms)`
+
+// Подкоманда storm-backend import; возвращает код завершения процесса
+func runImport(args []string) int {
+	if len(args) == 0 || args[0] != "hurdat2" {
+		fmt.Fprintln(os.Stderr, importUsage)
+		return 2
+	}
+	flags := flag.NewFlagSet("import hurdat2", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprintln(os.Stderr, importUsage) }
+	region := flags.String("region", "", "catalogue region for all storms in the file")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, importUsage)
+		return 2
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	records, err := hurdat2.Parse(file)
+	file.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", flags.Arg(0), err)
+		return 1
+	}
+	thresholds, err := classify.LoadFromEnv() // Классификация пика, как у обнаруженных штормов
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	if err := database.Connect(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer database.CloseDB()
+
+	ctx := context.Background()
+	fixes := 0
+	for _, record := range records {
+		target := *region
+		if target == "" {
+			target = hurdat2.BasinRegions[record.Basin]
+		}
+		if target == "" {
+			fmt.Fprintf(os.Stderr, "%s: unknown basin %q, pass -region\n", record.ID, record.Basin)
+			return 1
+		}
+		storm, track := hurdat2.Convert(record, target, thresholds)
+		if err := database.DB.ImportStorm(ctx, storm, track); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fixes += len(track)
+	}
+	fmt.Printf("imported %d storm(s), %d fix(es)\n", len(records), fixes)
+	return 0
+}
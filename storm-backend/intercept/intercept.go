@@ -0,0 +1,115 @@
+package intercept
+
+import (
+	"time"
+
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/models"
+)
+
+const (
+	DefaultTrackPoints = 6  // Точек трека для оценки движения шторма по умолчанию
+	MaxTrackPoints     = 50 // Больше — движение усредняется по слишком старому участку трека
+
+	Horizon = 72 * time.Hour // Дальше экстраполяция по прямой теряет смысл
+	step    = 5 * time.Minute
+)
+
+// Движение шторма по последним точкам трека
+type Motion struct {
+	SpeedKmH float64
+	Heading  float64 // Азимут движения, градусы от севера; 0 у неподвижного шторма
+	Points   int     // Сколько точек трека вошло в оценку
+}
+
+// Расчёт перехвата шторма охотником
+type Plan struct {
+	DistanceKm float64 // До последнего известного положения шторма по большому кругу
+	Bearing    float64 // Начальный азимут на последнее известное положение
+
+	Reachable   bool      // Охотник догоняет шторм в пределах Horizon
+	Intercept   geo.Point // Точка встречи
+	InterceptAt time.Time
+	TravelKm    float64 // Путь охотника до точки встречи
+	HeadingTo   float64 // Начальный азимут на точку встречи
+}
+
+// Оценка движения по последним n точкам трека: смещение от самой ранней точки к самой поздней
+// за прошедшее между ними время. Точки трека — узлы сетки региона с самым сильным ветром,
+// поэтому соседние пары шумят, а смещение по всему участку сглаживает скачки между узлами
+func EstimateMotion(track []models.TrackPoint, n int) Motion {
+	if n > len(track) {
+		n = len(track)
+	}
+	m := Motion{Points: n}
+	if n < 2 {
+		return m // Одной точки мало: шторм считается неподвижным
+	}
+	first, last := track[len(track)-n], track[len(track)-1]
+	hours := last.ObservedAt.Sub(first.ObservedAt).Hours()
+	if hours <= 0 {
+		return m
+	}
+	from, to := pointOf(first), pointOf(last)
+	if distance := geo.Distance(from, to); distance > 0 {
+		m.SpeedKmH = distance / hours
+		m.Heading = geo.Bearing(from, to)
+	}
+	return m
+}
+
+// Перехват шторма, последний раз замеченного в точке storm в момент observedAt, охотником из точки chaser,
+// который выезжает в момент now и едет по большому кругу со скоростью speedKmH. Шторм считается
+// движущимся по большому кругу с постоянными скоростью и азимутом motion
+func Compute(chaser geo.Point, speedKmH float64, storm geo.Point, observedAt time.Time, motion Motion, now time.Time) Plan {
+	plan := Plan{
+		DistanceKm: geo.Distance(chaser, storm),
+		Bearing:    geo.Bearing(chaser, storm),
+	}
+	if speedKmH <= 0 {
+		return plan
+	}
+
+	// Положение шторма через wait после выезда охотника
+	position := func(wait time.Duration) geo.Point {
+		elapsed := now.Add(wait).Sub(observedAt)
+		if elapsed < 0 || motion.SpeedKmH == 0 {
+			elapsed = 0
+		}
+		return geo.Destination(storm, motion.Heading, motion.SpeedKmH*elapsed.Hours())
+	}
+	// Отставание охотника: расстояние до шторма минус уже проделанный путь; встреча — первый ноль
+	gap := func(wait time.Duration) float64 {
+		return geo.Distance(chaser, position(wait)) - speedKmH*wait.Hours()
+	}
+
+	var lo, hi time.Duration
+	found := gap(0) <= 0
+	for t := step; !found && t <= Horizon; t += step {
+		if gap(t) <= 0 {
+			lo, hi, found = t-step, t, true
+		}
+	}
+	if !found {
+		return plan
+	}
+	for hi-lo > time.Second { // Уточнение делением пополам внутри шага
+		mid := lo + (hi-lo)/2
+		if gap(mid) <= 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	plan.Reachable = true
+	plan.Intercept = position(hi)
+	plan.InterceptAt = now.Add(hi).UTC()
+	plan.TravelKm = geo.Distance(chaser, plan.Intercept)
+	plan.HeadingTo = geo.Bearing(chaser, plan.Intercept)
+	return plan
+}
+
+func pointOf(p models.TrackPoint) geo.Point {
+	return geo.Point{Lat: float64(p.Lat), Lon: float64(p.Lon)}
+}
@@ -0,0 +1,154 @@
+package intercept
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/models"
+)
+
+const kmPerDegree = 2 * math.Pi * geo.EarthRadiusKm / 360
+
+var start = time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+
+// Трек из точек (lat, lon) через каждый час
+func hourlyTrack(points ...[2]float32) []models.TrackPoint {
+	track := make([]models.TrackPoint, 0, len(points))
+	for i, p := range points {
+		track = append(track, models.TrackPoint{ObservedAt: start.Add(time.Duration(i) * time.Hour), Lat: p[0], Lon: p[1]})
+	}
+	return track
+}
+
+func hours(h float64) time.Duration {
+	return time.Duration(h * float64(time.Hour))
+}
+
+func TestEstimateMotion(t *testing.T) {
+	tests := []struct {
+		name    string
+		track   []models.TrackPoint
+		n       int
+		speed   float64
+		heading float64
+		points  int
+	}{
+		{"empty track", nil, DefaultTrackPoints, 0, 0, 0},
+		{"single point is stationary", hourlyTrack([2]float32{20, -60}), DefaultTrackPoints, 0, 0, 1},
+		{"north at one degree an hour", hourlyTrack([2]float32{0, -60}, [2]float32{1, -60}, [2]float32{2, -60}), DefaultTrackPoints, kmPerDegree, 0, 3},
+		{"fewer points than n uses the whole track", hourlyTrack([2]float32{0, -60}, [2]float32{0, -61}), 10, kmPerDegree, 270, 2},
+		// Первые точки шли на восток, последние три — на юг
+		{"only the last n points count", hourlyTrack([2]float32{0, -62}, [2]float32{0, -61}, [2]float32{0, -60}, [2]float32{-1, -60}, [2]float32{-2, -60}), 3, kmPerDegree, 180, 3},
+		// Смещение считается от первой точки к последней, колебания между узлами сетки не влияют
+		{"zig-zag between grid nodes", hourlyTrack([2]float32{0, 0}, [2]float32{1, 0}, [2]float32{0, 0}, [2]float32{0, 0}), 4, 0, 0, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := EstimateMotion(tt.track, tt.n)
+			if math.Abs(m.SpeedKmH-tt.speed) > 0.01 || math.Abs(m.Heading-tt.heading) > 0.01 || m.Points != tt.points {
+				t.Errorf("EstimateMotion = %+v, want speed %.3f heading %.1f points %d", m, tt.speed, tt.heading, tt.points)
+			}
+		})
+	}
+
+	same := []models.TrackPoint{{ObservedAt: start, Lat: 0, Lon: 0}, {ObservedAt: start, Lat: 1, Lon: 0}}
+	if m := EstimateMotion(same, 2); m.SpeedKmH != 0 {
+		t.Errorf("points at the same time: %+v", m)
+	}
+}
+
+func TestCompute(t *testing.T) {
+	now := start.Add(time.Hour)
+	tests := []struct {
+		name       string
+		chaser     geo.Point
+		speed      float64
+		storm      geo.Point
+		observedAt time.Time
+		motion     Motion
+		distance   float64
+		bearing    float64
+		reachable  bool
+		wait       time.Duration
+		intercept  geo.Point
+	}{
+		{
+			name:   "stationary storm",
+			chaser: geo.Point{Lat: 0, Lon: 0}, speed: 100,
+			storm: geo.Point{Lat: 0, Lon: 1}, observedAt: now,
+			distance: kmPerDegree, bearing: 90,
+			reachable: true, wait: hours(kmPerDegree / 100), intercept: geo.Point{Lat: 0, Lon: 1},
+		},
+		{
+			name:   "storm coming towards the chaser meets halfway",
+			chaser: geo.Point{Lat: 0, Lon: 0}, speed: 100,
+			storm: geo.Point{Lat: 0, Lon: 1}, observedAt: now, motion: Motion{SpeedKmH: 100, Heading: 270},
+			distance: kmPerDegree, bearing: 90,
+			reachable: true, wait: hours(kmPerDegree / 200), intercept: geo.Point{Lat: 0, Lon: 0.5},
+		},
+		{
+			// Шторм замечен час назад в точке охотника и уходит на восток со скоростью 50 км/ч:
+			// сейчас он в 50 км, охотник на 100 км/ч догоняет его через час в 100 км от старта
+			name:   "storm observed an hour ago",
+			chaser: geo.Point{Lat: 0, Lon: 0}, speed: 100,
+			storm: geo.Point{Lat: 0, Lon: 0}, observedAt: start, motion: Motion{SpeedKmH: 50, Heading: 90},
+			distance: 0, bearing: 0,
+			reachable: true, wait: time.Hour, intercept: geo.Point{Lat: 0, Lon: 100 / kmPerDegree},
+		},
+		{
+			name:   "storm faster than the chaser",
+			chaser: geo.Point{Lat: 0, Lon: 0}, speed: 50,
+			storm: geo.Point{Lat: 0, Lon: 1}, observedAt: now, motion: Motion{SpeedKmH: 60, Heading: 90},
+			distance: kmPerDegree, bearing: 90,
+		},
+		{
+			name:   "storm out of reach within the horizon",
+			chaser: geo.Point{Lat: 0, Lon: 0}, speed: 10,
+			storm: geo.Point{Lat: 0, Lon: 10}, observedAt: now,
+			distance: 10 * kmPerDegree, bearing: 90,
+		},
+		{
+			name:   "chaser without speed",
+			chaser: geo.Point{Lat: 0, Lon: 0}, speed: 0,
+			storm: geo.Point{Lat: 1, Lon: 0}, observedAt: now,
+			distance: kmPerDegree, bearing: 0,
+		},
+		{
+			name:   "chaser inside the storm",
+			chaser: geo.Point{Lat: 25.8, Lon: -80.2}, speed: 80,
+			storm: geo.Point{Lat: 25.8, Lon: -80.2}, observedAt: now, motion: Motion{SpeedKmH: 20, Heading: 300},
+			distance: 0, bearing: 0,
+			reachable: true, wait: 0, intercept: geo.Point{Lat: 25.8, Lon: -80.2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := Compute(tt.chaser, tt.speed, tt.storm, tt.observedAt, tt.motion, now)
+			if math.Abs(plan.DistanceKm-tt.distance) > 0.01 || math.Abs(plan.Bearing-tt.bearing) > 0.01 {
+				t.Errorf("distance %.3f bearing %.2f, want %.3f %.2f", plan.DistanceKm, plan.Bearing, tt.distance, tt.bearing)
+			}
+			if plan.Reachable != tt.reachable {
+				t.Fatalf("Reachable = %v, want %v", plan.Reachable, tt.reachable)
+			}
+			if !tt.reachable {
+				if !plan.InterceptAt.IsZero() || plan.TravelKm != 0 {
+					t.Errorf("unreachable plan has an intercept: %+v", plan)
+				}
+				return
+			}
+			if d := plan.InterceptAt.Sub(now.Add(tt.wait)); d < -2*time.Second || d > 2*time.Second {
+				t.Errorf("InterceptAt = %v, want %v", plan.InterceptAt, now.Add(tt.wait))
+			}
+			if d := geo.Distance(plan.Intercept, tt.intercept); d > 0.1 {
+				t.Errorf("Intercept = %+v, want %+v (%.3f km off)", plan.Intercept, tt.intercept, d)
+			}
+			// Охотник проезжает ровно столько, сколько успевает за время до встречи
+			travel := tt.speed * plan.InterceptAt.Sub(now).Hours()
+			if math.Abs(plan.TravelKm-travel) > 0.1 {
+				t.Errorf("TravelKm = %.3f, want %.3f", plan.TravelKm, travel)
+			}
+		})
+	}
+}
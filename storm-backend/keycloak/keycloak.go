@@ -30,6 +30,7 @@ func InitJWKS() {
 	}
 	jwksURL = keycloakURL + "/realms/stormhunter-realm/protocol/openid-connect/certs" // Определяем эндпоинт для получения ключей
 	log.Info().Msgf("Initializing JWKS from: %s", jwksURL)
+	initVerifier(keycloakURL) // Параметры проверки издателя и аудитории
 
 	if err := FetchJWKS(); err != nil { // Загрузка JWKS при старте
 		log.Fatal().Err(err).Msg("Failed to fetch JWKS at startup") // Завершение работы при ошибке
@@ -0,0 +1,116 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Параметры проверки токенов
+var (
+	issuer   string              // Ожидаемый издатель токена (iss)
+	audience string              // Ожидаемая аудитория токена (aud)
+	leeway   = 30 * time.Second  // Допуск на расхождение часов для exp/nbf
+	methods  = []string{"RS256"} // Допустимые алгоритмы подписи
+)
+
+// Claims — проверенные утверждения из access-токена Keycloak
+type Claims struct {
+	jwt.RegisteredClaims
+	PreferredUsername string             `json:"preferred_username,omitempty"`
+	Email             string             `json:"email,omitempty"`
+	AuthorizedParty   string             `json:"azp,omitempty"`
+	RealmAccess       RoleSet            `json:"realm_access,omitempty"`
+	ResourceAccess    map[string]RoleSet `json:"resource_access,omitempty"`
+}
+
+// RoleSet — набор ролей в формате Keycloak ({"roles": [...]})
+type RoleSet struct {
+	Roles []string `json:"roles"`
+}
+
+// Ключ для хранения утверждений в контексте
+type claimsKey struct{}
+
+// Инициализация параметров проверки токенов из переменных окружения
+func initVerifier(keycloakURL string) {
+	issuer = os.Getenv("KEYCLOAK_ISSUER") // Издатель может отличаться от внутреннего адреса Keycloak (например, localhost:8081)
+	if issuer == "" {
+		issuer = keycloakURL + "/realms/stormhunter-realm"
+	}
+	audience = os.Getenv("KEYCLOAK_AUDIENCE")
+	if audience == "" {
+		audience = "storm-backend"
+	}
+}
+
+// Проверка подписи, срока действия, издателя и аудитории токена
+func VerifyToken(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string) // Идентификатор ключа, которым подписан токен
+		if !ok || kid == "" {
+			return nil, errors.New("token header has no kid")
+		}
+		return FetchRSAPubKeyFromJWKS(kid)
+	},
+		jwt.WithValidMethods(methods),
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(leeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("invalid token: missing sub claim")
+	}
+	return claims, nil
+}
+
+// Извлечение токена из значения заголовка Authorization
+func BearerToken(header string) (string, error) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(header), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", errors.New("authorization header must be in the form 'Bearer <token>'")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// Сохранение проверенных утверждений в контексте
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// Получение проверенных утверждений из контекста
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
+
+// Проверка наличия роли в realm_access или в resource_access указанного клиента
+func (c *Claims) HasRole(clientID, role string) bool {
+	for _, r := range c.RealmAccess.Roles {
+		if r == role {
+			return true
+		}
+	}
+	for _, r := range c.ResourceAccess[clientID].Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Идентификатор клиента, чьи роли учитываются при авторизации (совпадает с аудиторией)
+func ClientID() string {
+	return audience
+}
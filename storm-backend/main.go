@@ -7,34 +7,50 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
+	"Storm-Hunt/storm-backend/alerts"
+	"Storm-Hunt/storm-backend/catalog"
+	"Storm-Hunt/storm-backend/classify"
 	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/hub"
 	"Storm-Hunt/storm-backend/keycloak"
 	"Storm-Hunt/storm-backend/middleware"
 	"Storm-Hunt/storm-backend/proto"
 	"Storm-Hunt/storm-backend/rabbit"
+	"Storm-Hunt/storm-backend/storms"
+	"Storm-Hunt/storm-backend/subscription"
+	"Storm-Hunt/storm-backend/webhooks"
+	"Storm-Hunt/storm-backend/zones"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	_ "github.com/lib/pq"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
-// Инициализация проверочных ключей
-func init() {
-	keycloak.InitJWKS()
-}
-
 func main() {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr}) // Настройка логгера
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" { // Управление схемой БД без запуска сервера
+		os.Exit(runMigrate(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "import" { // Загрузка архивных штормов без запуска сервера
+		os.Exit(runImport(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "export" { // Выгрузка штормов и замеров в файл без запуска сервера
+		os.Exit(runExport(os.Args[2:]))
+	}
+
+	keycloak.InitJWKS() // Инициализация проверочных ключей
+
 	err := database.InitDB() // Инициализация базы данных из пакета database
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize database")
@@ -62,7 +78,7 @@ func main() {
 		log.Fatal().Err(err).Msg("Failed to open RabbitMQ channel")
 	}
 	_, err = amqpChan.QueueDeclare(
-		"weather_tasks",
+		rabbit.TasksQueue,
 		true,
 		false,
 		false,
@@ -72,20 +88,150 @@ func main() {
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to declare queue")
 	}
+	err = amqpChan.ExchangeDeclare( // Обменник для команд остановки опроса, которые должны дойти до всех воркеров
+		rabbit.ControlExchange,
+		amqp.ExchangeFanout,
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to declare control exchange")
+	}
+
+	batchSize := 100 // Размер пакета записи замеров
+	if raw := os.Getenv("OBSERVATION_BATCH"); raw != "" {
+		if batchSize, err = strconv.Atoi(raw); err != nil {
+			log.Fatal().Err(err).Msg("Invalid OBSERVATION_BATCH")
+		}
+	}
+	flushInterval := 5 * time.Second // Как долго копить неполный пакет
+	if raw := os.Getenv("OBSERVATION_FLUSH"); raw != "" {
+		if flushInterval, err = time.ParseDuration(raw); err != nil {
+			log.Fatal().Err(err).Msg("Invalid OBSERVATION_FLUSH")
+		}
+	}
+	detectorConfig, err := storms.ConfigFromEnv() // Пороги открытия и закрытия штормов
+	if err != nil {
+		log.Fatal().Err(err).Msg("Invalid storm detector configuration")
+	}
+	thresholds, err := classify.LoadFromEnv() // Пороги шкал Бофорта и Саффира–Симпсона
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load classification thresholds")
+	}
+	webhookConfig, err := webhooks.ConfigFromEnv() // Повторы и таймауты исходящих уведомлений
+	if err != nil {
+		log.Fatal().Err(err).Msg("Invalid webhook configuration")
+	}
+	webhookPublisher, err := webhooks.NewPublisher(amqpConn, database.DB, webhookConfig)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to set up webhook queues")
+	}
+	dispatcherCtx, stopDispatcher := context.WithCancel(ctx)
+	dispatcherDone := make(chan struct{})
+	go func() { // Доставка уведомлений из очереди с повторами
+		defer close(dispatcherDone)
+		if err := webhooks.NewDispatcher(amqpConn, database.DB, webhookConfig).Run(dispatcherCtx); err != nil {
+			log.Error().Err(err).Msg("Webhook dispatcher stopped")
+		}
+	}()
+
+	regions, err := catalog.LoadFromEnv() // Каталог регионов из REGIONS_FILE
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load region catalogue")
+	}
+
+	writer := rabbit.NewObservationWriter(amqpConn, database.DB, batchSize, flushInterval)
+	detector := storms.NewEngine(database.DB, redisClient, detectorConfig, thresholds) // Штормы по записанным циклам опроса
+	detector.Notifier = webhookPublisher                                               // Открытие и закрытие штормов уходят на webhooks
+	detector.Regions = regions                                                         // Штормы регионов без опроса закрываются по времени
+	writer.Detector = detector
+	writerCtx, stopWriter := context.WithCancel(ctx)
+	writerDone := make(chan struct{})
+	go func() { // Запись временного ряда замеров в базу
+		defer close(writerDone)
+		if err := writer.Run(writerCtx); err != nil {
+			log.Error().Err(err).Msg("Observation writer stopped")
+		}
+	}()
 
 	server := &rabbit.StormServer{
 		DB:       database.DB,
 		Redis:    redisClient,
 		AMQPConn: amqpConn,
 		AMQPChan: amqpChan,
+		Regions:  regions,
+
+		Thresholds:           thresholds,
+		AllowPrivateWebhooks: webhookConfig.AllowPrivate,
 	} // Создание экземпляра структуры для сервера с передачей DB и Redis
+	if raw := os.Getenv("STREAM_HEARTBEAT"); raw != "" { // Необязательный heartbeat в потоках, например 30s
+		heartbeat, err := time.ParseDuration(raw)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid STREAM_HEARTBEAT")
+		}
+		server.Heartbeat = heartbeat
+	}
+	server.KeepAlive = 15 * time.Second // Комментарии keep-alive в SSE, например 15s
+	if raw := os.Getenv("SSE_KEEPALIVE"); raw != "" {
+		if server.KeepAlive, err = time.ParseDuration(raw); err != nil || server.KeepAlive <= 0 {
+			log.Fatal().Err(err).Msg("Invalid SSE_KEEPALIVE")
+		}
+	}
+	hubBuffer := 16 // Ёмкость очереди одного потока в хабе
+	if raw := os.Getenv("HUB_BUFFER"); raw != "" {
+		if hubBuffer, err = strconv.Atoi(raw); err != nil {
+			log.Fatal().Err(err).Msg("Invalid HUB_BUFFER")
+		}
+	}
+	server.Hub = hub.New(redisClient, hubBuffer) // Одна подписка Redis на регион для всех потоков
+	server.StormHub = hub.NewWithPrefix(redisClient, storms.EventsPrefix, hubBuffer)
+	server.AlertHub = hub.NewWithPrefix(redisClient, alerts.EventsPrefix, hubBuffer)
+	server.ZoneHub = hub.NewWithPrefix(redisClient, zones.EventsPrefix, hubBuffer)
+	server.Subscriptions = subscription.NewRegistry(redisClient, server) // Учёт подписчиков регионов, общий для всех реплик
+	registryCtx, stopRegistry := context.WithCancel(ctx)
+	go server.Subscriptions.Run(registryCtx)
+
+	// Правила оповещений и зоны наблюдения проверяются на тех же обновлениях, что получают потоки клиентов
+	regionIDs := make([]string, 0, len(regions.Regions()))
+	for _, region := range regions.Regions() {
+		regionIDs = append(regionIDs, region.ID)
+	}
+	alertCtx, stopAlerts := context.WithCancel(ctx)
+	alertsDone := make(chan struct{})
+	go func() {
+		defer close(alertsDone)
+		engine := alerts.NewEngine(database.DB, redisClient, server.Hub)
+		engine.Notifier = webhookPublisher
+		engine.Subscriptions = server.Subscriptions // Регионы с правилами опрашиваются и без клиентов
+		engine.Run(alertCtx, regionIDs)
+	}()
+	detector.Subscriptions = server.Subscriptions // Регионы с webhooks штормов опрашиваются и без клиентов
+	detectorDone := make(chan struct{})
+	go func() {
+		defer close(detectorDone)
+		detector.Run(alertCtx)
+	}()
+	zonesDone := make(chan struct{})
+	go func() {
+		defer close(zonesDone)
+		engine := zones.NewEngine(database.DB, redisClient, server.Hub, server.StormHub)
+		engine.Notifier = webhookPublisher
+		engine.Subscriptions, engine.Regions = server.Subscriptions, regions // Регионы под зонами опрашиваются и без клиентов
+		engine.Run(alertCtx, regionIDs)
+	}()
 
 	gRPC_port := os.Getenv("GRPC_PORT")
 	lis, err := net.Listen("tcp", ":"+gRPC_port) // Создание TCP-слушателя для gRPC-сервера
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to listen port")
 	}
-	grpcServer := grpc.NewServer()                       // Создание gRPC-сервера
+	grpcServer := grpc.NewServer( // Создание gRPC-сервера с проверкой JWT и ролей для всех вызовов
+		grpc.ChainUnaryInterceptor(middleware.UnaryAuthInterceptor, middleware.UnaryPolicyInterceptor),
+		grpc.ChainStreamInterceptor(middleware.StreamAuthInterceptor, middleware.StreamPolicyInterceptor),
+	)
 	proto.RegisterStormServiceServer(grpcServer, server) // Регистрация сервиса StormService, реализующего методы .proto-файла
 
 	go func() { // Запуск gRPC-сервиса в отдельной горутине, чтобы не блокировать основной поток
@@ -95,23 +241,36 @@ func main() {
 		}
 	}()
 
-	gwMux := runtime.NewServeMux()                                                     // Создание мультиплексора для gRPC-Gateway, что позволяет преобразовывать gRPC-запросы в REST API
-	err = proto.RegisterStormServiceHandlerServer(context.Background(), gwMux, server) // Регистрация хендлера для StormService
+	gwMux := runtime.NewServeMux() // Создание мультиплексора для gRPC-Gateway, что позволяет преобразовывать gRPC-запросы в REST API
+	// Шлюз ходит в собственный gRPC-порт, чтобы REST-запросы проходили через те же перехватчики авторизации
+	err = proto.RegisterStormServiceHandlerFromEndpoint(ctx, gwMux, "localhost:"+gRPC_port, []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to register gateway")
 	}
 
+	// Потоковые HTTP-обработчики идут мимо шлюза, но с той же авторизацией, что и StartStream
+	restMux := http.NewServeMux()
+	restMux.Handle("/v1/storm/updates", middleware.RequireHTTP(proto.StormService_StartStream_FullMethodName, http.HandlerFunc(server.ServeUpdates)))
+	restMux.Handle("/v1/storm/ws", middleware.RequireHTTP(proto.StormService_StartStream_FullMethodName, http.HandlerFunc(server.ServeWebSocket)))
+	// Выгрузки файлами пишутся по мере чтения из базы; доступ как у соответствующих RPC
+	restMux.Handle("GET /v1/export/storms/{storm_id}", middleware.RequireHTTP(proto.StormService_GetStormTrack_FullMethodName, http.HandlerFunc(server.ServeStormExport)))
+	restMux.Handle("GET /v1/export/storms", middleware.RequireHTTP(proto.StormService_ListStorms_FullMethodName, http.HandlerFunc(server.ServeActiveStormsExport)))
+	restMux.Handle("GET /v1/export/observations", middleware.RequireHTTP(proto.StormService_GetObservations_FullMethodName, http.HandlerFunc(server.ServeObservationsExport)))
+	restMux.Handle("/", gwMux)
+
 	rest_port := os.Getenv("REST_PORT")
 	// Создание HTTP-сервера с таймаутами
 	httpServer := &http.Server{
 		Addr:         ":" + rest_port,
-		Handler:      middleware.CorsMiddleware(gwMux),
+		Handler:      middleware.CorsMiddleware(restMux),
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  120 * time.Second,
 	}
 
-	go func() { // Запуск HTTP-сервера в горутине с использованием corsMiddleware для CORS и gwMux для маршрутизации REST-запросов
+	go func() { // Запуск HTTP-сервера в горутине с использованием corsMiddleware для CORS и restMux для маршрутизации REST-запросов
 		log.Info().Msgf("REST server running on :%s", rest_port)
 		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatal().Err(err).Msg("Failed to serve REST")
@@ -126,6 +285,30 @@ func main() {
 
 	grpcServer.GracefulStop() // Graceful shutdown gRPC-сервера
 	log.Info().Msg("gRPC server stopped")
+	stopRegistry()
+	stopAlerts()
+	<-alertsDone
+	<-zonesDone
+	<-detectorDone
+	stopWriter()
+	<-writerDone // Дописываем накопленный пакет до закрытия БД и RabbitMQ
+	stopDispatcher()
+	<-dispatcherDone // Начатые доставки завершаются
+	if err := webhookPublisher.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close webhook channel")
+	}
+	if err := server.Hub.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close update hub")
+	}
+	if err := server.StormHub.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close storm event hub")
+	}
+	if err := server.AlertHub.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close alert event hub")
+	}
+	if err := server.ZoneHub.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close zone event hub")
+	}
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second) // Graceful shutdown HTTP-сервера
 	defer cancel()
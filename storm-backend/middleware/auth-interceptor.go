@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+
+	"Storm-Hunt/storm-backend/keycloak"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Обёртка над серверным стримом с подменённым контекстом
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// Unary-перехватчик: проверка токена и сохранение утверждений в контексте
+func UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	authCtx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(authCtx, req)
+}
+
+// Stream-перехватчик: то же самое для потоковых вызовов
+func StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	authCtx, err := authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: authCtx})
+}
+
+// Извлечение и проверка bearer-токена из метаданных запроса
+func authenticate(ctx context.Context, method string) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing request metadata")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	return verifyAuthorization(ctx, values[0], method)
+}
+
+// Проверка значения заголовка Authorization; общая для gRPC и HTTP-потоков
+func verifyAuthorization(ctx context.Context, header, method string) (context.Context, error) {
+	token, err := keycloak.BearerToken(header)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	claims, err := keycloak.VerifyToken(token)
+	if err != nil {
+		log.Warn().Err(err).Str("method", method).Msg("Rejected request with invalid token")
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return keycloak.NewContext(ctx, claims), nil
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+)
+
+// Проверка токена и ролей для HTTP-обработчиков вне gRPC-шлюза (SSE, WebSocket).
+// Доступ проверяется по политике gRPC-метода method, поэтому права совпадают с gRPC.
+// Браузерные EventSource и WebSocket не умеют ставить заголовки, поэтому токен
+// можно передать и параметром access_token
+func RequireHTTP(method string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			if token := r.URL.Query().Get("access_token"); token != "" {
+				header = "Bearer " + token
+			}
+		}
+		if header == "" {
+			http.Error(w, "missing authorization header", http.StatusUnauthorized)
+			return
+		}
+
+		ctx, err := verifyAuthorization(r.Context(), header, method)
+		if err == nil {
+			err = Authorize(ctx, method)
+		}
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"Storm-Hunt/storm-backend/keycloak"
+	"Storm-Hunt/storm-backend/proto"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Роли stormhunter-realm. В Keycloak они составные: admin включает chaser, chaser включает viewer
+const (
+	RoleViewer = "viewer"
+	RoleChaser = "chaser"
+	RoleAdmin  = "admin"
+)
+
+// Правило доступа: вызов разрешён, если у пользователя есть хотя бы одна из ролей
+type Rule struct {
+	AnyOf []string
+}
+
+// Декларативная таблица политик по полному имени gRPC-метода.
+// Методы, отсутствующие в таблице, запрещены для всех
+var Policies = map[string]Rule{
+	proto.StormService_StartStream_FullMethodName:       {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_StopStream_FullMethodName:        {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_ListRegions_FullMethodName:       {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_GetObservations_FullMethodName:   {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_ListStorms_FullMethodName:        {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_GetStorm_FullMethodName:          {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_GetStormTrack_FullMethodName:     {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_PlanIntercept_FullMethodName:     {AnyOf: []string{RoleChaser, RoleAdmin}},
+	proto.StormService_CreateAlertRule_FullMethodName:   {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_ListAlertRules_FullMethodName:    {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_DeleteAlertRule_FullMethodName:   {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_ListAlertEvents_FullMethodName:   {AnyOf: []string{RoleViewer, RoleChaser, RoleAdmin}},
+	proto.StormService_CreateWebhook_FullMethodName:     {AnyOf: []string{RoleChaser, RoleAdmin}},
+	proto.StormService_ListWebhooks_FullMethodName:      {AnyOf: []string{RoleChaser, RoleAdmin}},
+	proto.StormService_DeleteWebhook_FullMethodName:     {AnyOf: []string{RoleChaser, RoleAdmin}},
+	proto.StormService_CreateWatchZone_FullMethodName:   {AnyOf: []string{RoleChaser, RoleAdmin}},
+	proto.StormService_ListWatchZones_FullMethodName:    {AnyOf: []string{RoleChaser, RoleAdmin}},
+	proto.StormService_DeleteWatchZone_FullMethodName:   {AnyOf: []string{RoleChaser, RoleAdmin}},
+	proto.StormService_ListSubscriptions_FullMethodName: {AnyOf: []string{RoleAdmin}},
+}
+
+// Unary-перехватчик авторизации (должен идти после UnaryAuthInterceptor)
+func UnaryPolicyInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := Authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream-перехватчик авторизации (должен идти после StreamAuthInterceptor)
+func StreamPolicyInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := Authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// Проверка ролей пользователя по таблице политик
+func Authorize(ctx context.Context, method string) error {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+
+	rule, ok := Policies[method]
+	if !ok {
+		log.Warn().Str("method", method).Str("user", claims.Subject).Msg("No policy defined for method")
+		return status.Errorf(codes.PermissionDenied, "no access policy defined for %s", method)
+	}
+
+	for _, role := range rule.AnyOf {
+		if claims.HasRole(keycloak.ClientID(), role) {
+			return nil
+		}
+	}
+
+	log.Warn().Str("method", method).Str("user", claims.Subject).Strs("required", rule.AnyOf).Msg("Permission denied")
+	return status.Errorf(codes.PermissionDenied, "%s requires one of roles [%s] in realm_access or resource_access.%s",
+		method, strings.Join(rule.AnyOf, ", "), keycloak.ClientID())
+}
@@ -6,5 +6,11 @@ type CacheData struct {
 	Temp      float32 `json:"temp"`
 	Humidity  int     `json:"humidity"`
 	WindKmH   int     `json:"wind_kmh"`
+	Pressure  float32 `json:"pressure,omitempty"`
+	Provider  string  `json:"provider,omitempty"`
+	Point     string  `json:"point,omitempty"`
 	Timestamp string  `json:"timestamp"`
+	Sequence  uint64  `json:"sequence,omitempty"` // Номер обновления региона от weather-worker
+
+	ObservedAt string `json:"observed_at,omitempty"`
 }
@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+// Показатели замера, по которым строятся правила
+const (
+	MetricWindKmH  = "wind_kmh"
+	MetricTemp     = "temp"
+	MetricHumidity = "humidity"
+	MetricPressure = "pressure"
+)
+
+// Условия правила: сравнение с порогом или изменение за окно времени
+const (
+	OperatorGT   = "gt"   // Больше порога
+	OperatorGTE  = "gte"  // Не меньше порога
+	OperatorLT   = "lt"   // Меньше порога
+	OperatorLTE  = "lte"  // Не больше порога
+	OperatorRise = "rise" // Вырос не меньше чем на value за Window
+	OperatorFall = "fall" // Упал не меньше чем на value за Window
+)
+
+// Состояния оповещения
+const (
+	AlertFiring   = "firing"
+	AlertResolved = "resolved"
+)
+
+// Правило оповещения пользователя, например "wind_kmh > 90 в Atlantic 2 обновления подряд"
+// или "humidity выросла на 20 за 30 минут"
+type AlertRule struct {
+	ID         int64         `json:"id"`
+	UserID     string        `json:"user_id"` // subject токена Keycloak
+	Name       string        `json:"name"`
+	Region     string        `json:"region"`
+	Metric     string        `json:"metric"`
+	Operator   string        `json:"operator"`
+	Value      float64       `json:"value"`
+	ForUpdates int           `json:"for_updates"` // Для порогов: сколько обновлений подряд условие должно выполняться
+	Window     time.Duration `json:"window"`      // Для rise/fall: за какое время считается изменение
+	Cooldown   time.Duration `json:"cooldown"`    // Минимальный интервал между срабатываниями
+	Enabled    bool          `json:"enabled"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// Срабатывание или снятие оповещения
+type AlertEvent struct {
+	ID         int64     `json:"id"`
+	RuleID     int64     `json:"rule_id"`
+	UserID     string    `json:"user_id"`
+	RuleName   string    `json:"rule_name"`
+	Region     string    `json:"region"`
+	State      string    `json:"state"`
+	Value      float64   `json:"value"` // Значение показателя (или его изменение для rise/fall)
+	Message    string    `json:"message"`
+	Sequence   uint64    `json:"sequence"` // Обновление региона, на котором изменилось состояние
+	OccurredAt time.Time `json:"occurred_at"`
+}
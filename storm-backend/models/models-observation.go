@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// Нормализованный замер в точке региона, который weather-worker отправляет в очередь weather_observations
+type Observation struct {
+	Region     string    `json:"region"`
+	Point      string    `json:"point"`
+	Lat        float32   `json:"lat"`
+	Lon        float32   `json:"lon"`
+	Temp       float32   `json:"temp"`
+	Humidity   int       `json:"humidity"`
+	WindKmH    float32   `json:"wind_kmh"`
+	Pressure   float32   `json:"pressure,omitempty"` // 0 — источник не сообщил давление
+	Provider   string    `json:"provider"`
+	ObservedAt time.Time `json:"observed_at"`
+}
+
+// Минимум, максимум и среднее метрики в интервале
+type MetricStats struct {
+	Min float64
+	Max float64
+	Avg float64
+}
+
+// Агрегированный интервал временного ряда; при выборке без агрегации — один замер
+type ObservationBucket struct {
+	ID       int64 // Идентификатор замера (только без агрегации, нужен для курсора)
+	Start    time.Time
+	Samples  int
+	Point    string // Только без агрегации
+	Provider string // Только без агрегации
+	Temp     MetricStats
+	Humidity MetricStats
+	WindKmH  MetricStats
+	Pressure *MetricStats // nil, если ни один замер не содержит давления
+}
+
+// Все замеры одного цикла опроса региона (сообщение очереди weather_observations)
+type ObservationCycle struct {
+	Region       string        `json:"region"`
+	FetchedAt    time.Time     `json:"fetched_at"`
+	Observations []Observation `json:"observations"`
+}
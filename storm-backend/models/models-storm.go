@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+
+	"Storm-Hunt/storm-backend/geo"
+)
+
+// Статусы шторма
+const (
+	StormOpen   = "open"   // Шторм продолжается, трек пополняется
+	StormClosed = "closed" // Ветер стих на заданное число циклов
+)
+
+// Источники штормов
+const (
+	StormSourceLive    = "live"    // Обнаружен детектором по замерам
+	StormSourceHURDAT2 = "hurdat2" // Импортирован из best track NOAA HURDAT2
+)
+
+// Шторм, обнаруженный по замерам региона или импортированный из архива
+type Storm struct {
+	ID        string     `json:"id"` // Стабильный идентификатор: регион и время начала или hurdat2-<ATCF id>
+	Region    string     `json:"region"`
+	Name      string     `json:"name,omitempty"` // Имя из архива; у обнаруженных штормов пусто
+	Source    string     `json:"source"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"` // nil, пока шторм открыт
+	UpdatedAt time.Time  `json:"updated_at"`
+
+	// Последнее известное положение и ветер
+	Lat     float32 `json:"lat"`
+	Lon     float32 `json:"lon"`
+	WindKmH float32 `json:"wind_kmh"`
+
+	// Пиковая интенсивность
+	PeakWindKmH float32   `json:"peak_wind_kmh"`
+	PeakAt      time.Time `json:"peak_at"`
+	MinPressure float32   `json:"min_pressure,omitempty"` // 0 — давление не сообщалось
+
+	// Классификация пика
+	Beaufort int   `json:"beaufort"`
+	Category int   `json:"category"` // Категория Саффира–Симпсона, 0 — не ураган
+	Severity int32 `json:"severity"` // Значение classify.Severity
+}
+
+// Точка трека шторма: точка региона с самым сильным ветром в цикле опроса
+type TrackPoint struct {
+	ObservedAt time.Time `json:"observed_at"`
+	Point      string    `json:"point"`
+	Lat        float32   `json:"lat"`
+	Lon        float32   `json:"lon"`
+	WindKmH    float32   `json:"wind_kmh"`
+	Pressure   float32   `json:"pressure,omitempty"`
+
+	// Только у точек best track
+	Record    string     `json:"record,omitempty"` // Идентификатор записи HURDAT2, например L — выход на сушу
+	Status    string     `json:"status,omitempty"` // Статус системы: TD, TS, HU, EX и т.д.
+	WindRadii *WindRadii `json:"wind_radii,omitempty"`
+}
+
+// Радиусы ветра 34, 50 и 64 узла по квадрантам NE, SE, SW, NW, км; 0 — ветра такой силы нет или нет данных
+type WindRadii struct {
+	Kt34          [4]float32 `json:"kt34"`
+	Kt50          [4]float32 `json:"kt50"`
+	Kt64          [4]float32 `json:"kt64"`
+	MaxWindRadius float32    `json:"max_wind_radius,omitempty"` // Радиус максимального ветра
+}
+
+// События жизненного цикла шторма
+const (
+	StormEventOpened  = "opened"
+	StormEventUpdated = "updated"
+	StormEventClosed  = "closed"
+)
+
+// Событие шторма, которое детектор публикует в Redis для потоков клиентов
+type StormEvent struct {
+	Event string      `json:"event"`
+	Storm Storm       `json:"storm"`
+	Point *TrackPoint `json:"point,omitempty"` // Новая точка трека, если она появилась в этом цикле
+
+	Forecast *StormForecast `json:"forecast,omitempty"` // Только у открытого шторма с двумя точками трека и больше
+}
+
+// Экстраполяция положения шторма по прямой с конусом неопределённости
+type StormForecast struct {
+	IssuedAt  time.Time          `json:"issued_at"` // Время последней точки трека, от него отсчитываются сроки
+	Origin    geo.Point          `json:"origin"`
+	SpeedKmH  float64            `json:"speed_kmh"`
+	Heading   float64            `json:"heading"` // Азимут движения, градусы от севера
+	Positions []ForecastPosition `json:"positions"`
+	Cone      []geo.Point        `json:"cone"` // Контур конуса без повтора первой вершины
+}
+
+// Ожидаемое положение шторма через LeadHours после IssuedAt
+type ForecastPosition struct {
+	LeadHours int       `json:"lead_hours"`
+	ValidAt   time.Time `json:"valid_at"`
+	Lat       float64   `json:"lat"`
+	Lon       float64   `json:"lon"`
+	RadiusKm  float64   `json:"radius_km"` // Радиус неопределённости
+}
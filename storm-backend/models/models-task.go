@@ -1,6 +1,16 @@
 package models
 
+// Действия, которые понимает weather-worker
+const (
+	TaskActionStart = "start" // Начать опрос региона
+	TaskActionStop  = "stop"  // Остановить опрос региона
+)
+
 type WeatherTask struct {
 	Region string `json:"region"`
 	UserID string `json:"user_id"`
+	Action string `json:"action,omitempty"` // Пустое значение трактуется как start
+
+	// Поколение опроса: воркер игнорирует stop со старым поколением, если уже получил более новый start
+	Generation int64 `json:"generation,omitempty"`
 }
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Типы событий в уведомлениях
+const (
+	WebhookAlertFiring   = "alert.firing"
+	WebhookAlertResolved = "alert.resolved"
+	WebhookStormOpened   = "storm.opened"
+	WebhookStormClosed   = "storm.closed"
+	WebhookZoneEntered   = "zone.entered"
+	WebhookZoneExited    = "zone.exited"
+)
+
+// Адрес, на который отправляются уведомления пользователя
+type Webhook struct {
+	ID        int64     `json:"id"`
+	UserID    string    `json:"user_id"` // subject токена Keycloak
+	URL       string    `json:"url"`
+	Secret    string    `json:"-"`      // Ключ HMAC-SHA256 подписи тела
+	Alerts    bool      `json:"alerts"` // Оповещения по правилам пользователя
+	Storms    bool      `json:"storms"` // Открытие и закрытие штормов
+	Zones     bool      `json:"zones"`  // Вход в зоны наблюдения пользователя и выход из них
+	Region    string    `json:"region"` // Фильтр штормов по региону, пусто — все регионы
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Тело уведомления
+type WebhookPayload struct {
+	ID        string          `json:"id"` // Одинаков во всех попытках доставки, по нему получатель отбрасывает повторы
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"` // AlertEvent, StormEvent или ZoneEvent
+}
+
+// Задача доставки в очереди RabbitMQ
+type WebhookDelivery struct {
+	ID        string          `json:"id"` // id из тела уведомления
+	WebhookID int64           `json:"webhook_id"`
+	Event     string          `json:"event"`
+	Body      json.RawMessage `json:"body"`    // Готовое тело WebhookPayload; подписывается при каждой попытке
+	Attempt   int             `json:"attempt"` // Номер следующей попытки, с 1
+}
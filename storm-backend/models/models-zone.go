@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"Storm-Hunt/storm-backend/geo"
+)
+
+// Формы зоны
+const (
+	ZoneCircle  = "circle"
+	ZonePolygon = "polygon"
+)
+
+// Переходы через границу зоны
+const (
+	ZoneEnter = "enter"
+	ZoneExit  = "exit"
+)
+
+// Источники положения, за которыми следят зоны
+const (
+	ZoneSourceStorm  = "storm"  // Положение отслеживаемого шторма
+	ZoneSourceRegion = "region" // Точка региона с самым сильным ветром в обновлении
+)
+
+// Зона наблюдения пользователя: круг вокруг базы или многоугольник района охоты
+type WatchZone struct {
+	ID         int64       `json:"id"`
+	UserID     string      `json:"user_id"` // subject токена Keycloak
+	Name       string      `json:"name"`
+	Shape      string      `json:"shape"`
+	Center     geo.Point   `json:"center"` // Для круга
+	RadiusKm   float64     `json:"radius_km"`
+	Polygon    []geo.Point `json:"polygon"`      // Для многоугольника, без повтора первой вершины
+	MinWindKmH float32     `json:"min_wind_kmh"` // Порог ветра регионов; 0 — любой ветер
+	Bounds     geo.Bounds  `json:"-"`            // Описанный прямоугольник для выборки из базы
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// Вход в зону или выход из неё
+type ZoneEvent struct {
+	ZoneID     int64     `json:"zone_id"`
+	UserID     string    `json:"user_id"`
+	ZoneName   string    `json:"zone_name"`
+	Event      string    `json:"event"`
+	Source     string    `json:"source"`
+	StormID    string    `json:"storm_id,omitempty"` // Для source=storm
+	Region     string    `json:"region"`
+	Lat        float64   `json:"lat"`
+	Lon        float64   `json:"lon"`
+	WindKmH    float32   `json:"wind_kmh"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,10 +23,338 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Степень опасности ветра по шкале Бофорта и категории урагана (пороги настраиваются)
+type Severity int32
+
+const (
+	Severity_SEVERITY_NONE     Severity = 0
+	Severity_SEVERITY_LOW      Severity = 1 // Сильный ветер
+	Severity_SEVERITY_MODERATE Severity = 2 // Шторм
+	Severity_SEVERITY_HIGH     Severity = 3 // Сильный шторм или ураган 1–2 категории
+	Severity_SEVERITY_EXTREME  Severity = 4 // Ураганный ветер или крупный ураган
+)
+
+// Enum value maps for Severity.
+var (
+	Severity_name = map[int32]string{
+		0: "SEVERITY_NONE",
+		1: "SEVERITY_LOW",
+		2: "SEVERITY_MODERATE",
+		3: "SEVERITY_HIGH",
+		4: "SEVERITY_EXTREME",
+	}
+	Severity_value = map[string]int32{
+		"SEVERITY_NONE":     0,
+		"SEVERITY_LOW":      1,
+		"SEVERITY_MODERATE": 2,
+		"SEVERITY_HIGH":     3,
+		"SEVERITY_EXTREME":  4,
+	}
+)
+
+func (x Severity) Enum() *Severity {
+	p := new(Severity)
+	*p = x
+	return p
+}
+
+func (x Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_storm_proto_enumTypes[0].Descriptor()
+}
+
+func (Severity) Type() protoreflect.EnumType {
+	return &file_storm_proto_enumTypes[0]
+}
+
+func (x Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Severity.Descriptor instead.
+func (Severity) EnumDescriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{0}
+}
+
+// Размер интервала агрегации замеров
+type Bucket int32
+
+const (
+	Bucket_BUCKET_RAW Bucket = 0 // Замеры без агрегации
+	Bucket_BUCKET_1M  Bucket = 1
+	Bucket_BUCKET_10M Bucket = 2
+	Bucket_BUCKET_1H  Bucket = 3
+)
+
+// Enum value maps for Bucket.
+var (
+	Bucket_name = map[int32]string{
+		0: "BUCKET_RAW",
+		1: "BUCKET_1M",
+		2: "BUCKET_10M",
+		3: "BUCKET_1H",
+	}
+	Bucket_value = map[string]int32{
+		"BUCKET_RAW": 0,
+		"BUCKET_1M":  1,
+		"BUCKET_10M": 2,
+		"BUCKET_1H":  3,
+	}
+)
+
+func (x Bucket) Enum() *Bucket {
+	p := new(Bucket)
+	*p = x
+	return p
+}
+
+func (x Bucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Bucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_storm_proto_enumTypes[1].Descriptor()
+}
+
+func (Bucket) Type() protoreflect.EnumType {
+	return &file_storm_proto_enumTypes[1]
+}
+
+func (x Bucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Bucket.Descriptor instead.
+func (Bucket) EnumDescriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{1}
+}
+
+type StormStatus int32
+
+const (
+	StormStatus_STORM_STATUS_ANY    StormStatus = 0 // Без фильтра
+	StormStatus_STORM_STATUS_ACTIVE StormStatus = 1 // Шторм продолжается
+	StormStatus_STORM_STATUS_CLOSED StormStatus = 2
+)
+
+// Enum value maps for StormStatus.
+var (
+	StormStatus_name = map[int32]string{
+		0: "STORM_STATUS_ANY",
+		1: "STORM_STATUS_ACTIVE",
+		2: "STORM_STATUS_CLOSED",
+	}
+	StormStatus_value = map[string]int32{
+		"STORM_STATUS_ANY":    0,
+		"STORM_STATUS_ACTIVE": 1,
+		"STORM_STATUS_CLOSED": 2,
+	}
+)
+
+func (x StormStatus) Enum() *StormStatus {
+	p := new(StormStatus)
+	*p = x
+	return p
+}
+
+func (x StormStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StormStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_storm_proto_enumTypes[2].Descriptor()
+}
+
+func (StormStatus) Type() protoreflect.EnumType {
+	return &file_storm_proto_enumTypes[2]
+}
+
+func (x StormStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StormStatus.Descriptor instead.
+func (StormStatus) EnumDescriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{2}
+}
+
+// Показатель обновления региона, по которому срабатывает правило
+type AlertMetric int32
+
+const (
+	AlertMetric_ALERT_METRIC_UNSPECIFIED AlertMetric = 0
+	AlertMetric_ALERT_METRIC_WIND_KMH    AlertMetric = 1
+	AlertMetric_ALERT_METRIC_TEMP        AlertMetric = 2
+	AlertMetric_ALERT_METRIC_HUMIDITY    AlertMetric = 3
+	AlertMetric_ALERT_METRIC_PRESSURE    AlertMetric = 4 // Обновления без давления правило пропускает
+)
+
+// Enum value maps for AlertMetric.
+var (
+	AlertMetric_name = map[int32]string{
+		0: "ALERT_METRIC_UNSPECIFIED",
+		1: "ALERT_METRIC_WIND_KMH",
+		2: "ALERT_METRIC_TEMP",
+		3: "ALERT_METRIC_HUMIDITY",
+		4: "ALERT_METRIC_PRESSURE",
+	}
+	AlertMetric_value = map[string]int32{
+		"ALERT_METRIC_UNSPECIFIED": 0,
+		"ALERT_METRIC_WIND_KMH":    1,
+		"ALERT_METRIC_TEMP":        2,
+		"ALERT_METRIC_HUMIDITY":    3,
+		"ALERT_METRIC_PRESSURE":    4,
+	}
+)
+
+func (x AlertMetric) Enum() *AlertMetric {
+	p := new(AlertMetric)
+	*p = x
+	return p
+}
+
+func (x AlertMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_storm_proto_enumTypes[3].Descriptor()
+}
+
+func (AlertMetric) Type() protoreflect.EnumType {
+	return &file_storm_proto_enumTypes[3]
+}
+
+func (x AlertMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertMetric.Descriptor instead.
+func (AlertMetric) EnumDescriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{3}
+}
+
+type AlertOperator int32
+
+const (
+	AlertOperator_ALERT_OPERATOR_UNSPECIFIED AlertOperator = 0
+	AlertOperator_ALERT_OPERATOR_GT          AlertOperator = 1
+	AlertOperator_ALERT_OPERATOR_GTE         AlertOperator = 2
+	AlertOperator_ALERT_OPERATOR_LT          AlertOperator = 3
+	AlertOperator_ALERT_OPERATOR_LTE         AlertOperator = 4
+	AlertOperator_ALERT_OPERATOR_RISE        AlertOperator = 5 // Показатель вырос не меньше чем на value за window_seconds
+	AlertOperator_ALERT_OPERATOR_FALL        AlertOperator = 6 // Показатель упал не меньше чем на value за window_seconds
+)
+
+// Enum value maps for AlertOperator.
+var (
+	AlertOperator_name = map[int32]string{
+		0: "ALERT_OPERATOR_UNSPECIFIED",
+		1: "ALERT_OPERATOR_GT",
+		2: "ALERT_OPERATOR_GTE",
+		3: "ALERT_OPERATOR_LT",
+		4: "ALERT_OPERATOR_LTE",
+		5: "ALERT_OPERATOR_RISE",
+		6: "ALERT_OPERATOR_FALL",
+	}
+	AlertOperator_value = map[string]int32{
+		"ALERT_OPERATOR_UNSPECIFIED": 0,
+		"ALERT_OPERATOR_GT":          1,
+		"ALERT_OPERATOR_GTE":         2,
+		"ALERT_OPERATOR_LT":          3,
+		"ALERT_OPERATOR_LTE":         4,
+		"ALERT_OPERATOR_RISE":        5,
+		"ALERT_OPERATOR_FALL":        6,
+	}
+)
+
+func (x AlertOperator) Enum() *AlertOperator {
+	p := new(AlertOperator)
+	*p = x
+	return p
+}
+
+func (x AlertOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_storm_proto_enumTypes[4].Descriptor()
+}
+
+func (AlertOperator) Type() protoreflect.EnumType {
+	return &file_storm_proto_enumTypes[4]
+}
+
+func (x AlertOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertOperator.Descriptor instead.
+func (AlertOperator) EnumDescriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{4}
+}
+
+type AlertState int32
+
+const (
+	AlertState_ALERT_STATE_UNSPECIFIED AlertState = 0
+	AlertState_ALERT_STATE_FIRING      AlertState = 1
+	AlertState_ALERT_STATE_RESOLVED    AlertState = 2
+)
+
+// Enum value maps for AlertState.
+var (
+	AlertState_name = map[int32]string{
+		0: "ALERT_STATE_UNSPECIFIED",
+		1: "ALERT_STATE_FIRING",
+		2: "ALERT_STATE_RESOLVED",
+	}
+	AlertState_value = map[string]int32{
+		"ALERT_STATE_UNSPECIFIED": 0,
+		"ALERT_STATE_FIRING":      1,
+		"ALERT_STATE_RESOLVED":    2,
+	}
+)
+
+func (x AlertState) Enum() *AlertState {
+	p := new(AlertState)
+	*p = x
+	return p
+}
+
+func (x AlertState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertState) Descriptor() protoreflect.EnumDescriptor {
+	return file_storm_proto_enumTypes[5].Descriptor()
+}
+
+func (AlertState) Type() protoreflect.EnumType {
+	return &file_storm_proto_enumTypes[5]
+}
+
+func (x AlertState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertState.Descriptor instead.
+func (AlertState) EnumDescriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{5}
+}
+
 type StartStreamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Region string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Устарело: пользователь определяется по проверенному токену, значение игнорируется
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// sequence последнего полученного обновления: пропущенные после него обновления
+	// досылаются из истории до перехода к живым. 0 — начать с последнего замера
+	ResumeFrom    uint64 `protobuf:"varint,3,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,33 +403,34 @@ func (x *StartStreamRequest) GetUserId() string {
 	return ""
 }
 
-type WeatherData struct {
+func (x *StartStreamRequest) GetResumeFrom() uint64 {
+	if x != nil {
+		return x.ResumeFrom
+	}
+	return 0
+}
+
+type StopStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
-	Temp          float32                `protobuf:"fixed32,2,opt,name=temp,proto3" json:"temp,omitempty"`
-	Humidity      float32                `protobuf:"fixed32,3,opt,name=humidity,proto3" json:"humidity,omitempty"`
-	Lat           float32                `protobuf:"fixed32,4,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lon           float32                `protobuf:"fixed32,5,opt,name=lon,proto3" json:"lon,omitempty"`
-	WindKmh       int32                  `protobuf:"varint,6,opt,name=wind_kmh,json=windKmh,proto3" json:"wind_kmh,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WeatherData) Reset() {
-	*x = WeatherData{}
+func (x *StopStreamRequest) Reset() {
+	*x = StopStreamRequest{}
 	mi := &file_storm_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WeatherData) String() string {
+func (x *StopStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WeatherData) ProtoMessage() {}
+func (*StopStreamRequest) ProtoMessage() {}
 
-func (x *WeatherData) ProtoReflect() protoreflect.Message {
+func (x *StopStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storm_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -112,78 +442,3209 @@ func (x *WeatherData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WeatherData.ProtoReflect.Descriptor instead.
-func (*WeatherData) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopStreamRequest.ProtoReflect.Descriptor instead.
+func (*StopStreamRequest) Descriptor() ([]byte, []int) {
 	return file_storm_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *WeatherData) GetRegion() string {
+func (x *StopStreamRequest) GetRegion() string {
 	if x != nil {
 		return x.Region
 	}
 	return ""
 }
 
-func (x *WeatherData) GetTemp() float32 {
+type StopStreamResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Region         string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	StoppedStreams int32                  `protobuf:"varint,2,opt,name=stopped_streams,json=stoppedStreams,proto3" json:"stopped_streams,omitempty"` // Сколько потоков пользователя было закрыто
+	// В этой реплике не осталось потоков региона. Это не значит, что опрос остановлен: его держат подписчики
+	// других реплик, а без них он остановится только после grace-периода реестра подписок
+	NoLocalStreams bool `protobuf:"varint,3,opt,name=no_local_streams,json=noLocalStreams,proto3" json:"no_local_streams,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StopStreamResponse) Reset() {
+	*x = StopStreamResponse{}
+	mi := &file_storm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopStreamResponse) ProtoMessage() {}
+
+func (x *StopStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[2]
 	if x != nil {
-		return x.Temp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WeatherData) GetHumidity() float32 {
+// Deprecated: Use StopStreamResponse.ProtoReflect.Descriptor instead.
+func (*StopStreamResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StopStreamResponse) GetRegion() string {
 	if x != nil {
-		return x.Humidity
+		return x.Region
+	}
+	return ""
+}
+
+func (x *StopStreamResponse) GetStoppedStreams() int32 {
+	if x != nil {
+		return x.StoppedStreams
 	}
 	return 0
 }
 
-func (x *WeatherData) GetLat() float32 {
+func (x *StopStreamResponse) GetNoLocalStreams() bool {
 	if x != nil {
-		return x.Lat
+		return x.NoLocalStreams
+	}
+	return false
+}
+
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_storm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{3}
+}
+
+type RegionSubscriptions struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Region           string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Subscribers      int64                  `protobuf:"varint,2,opt,name=subscribers,proto3" json:"subscribers,omitempty"`                                   // Активные подписчики во всех репликах
+	Lingering        int64                  `protobuf:"varint,3,opt,name=lingering,proto3" json:"lingering,omitempty"`                                       // Ушедшие подписчики в пределах grace-периода
+	LocalSubscribers int32                  `protobuf:"varint,4,opt,name=local_subscribers,json=localSubscribers,proto3" json:"local_subscribers,omitempty"` // Подписчики в реплике, ответившей на запрос
+	Generation       int64                  `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`                                     // Поколение опроса в воркере, 0 — опрос не запрошен
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RegionSubscriptions) Reset() {
+	*x = RegionSubscriptions{}
+	mi := &file_storm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionSubscriptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionSubscriptions) ProtoMessage() {}
+
+func (x *RegionSubscriptions) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionSubscriptions.ProtoReflect.Descriptor instead.
+func (*RegionSubscriptions) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RegionSubscriptions) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegionSubscriptions) GetSubscribers() int64 {
+	if x != nil {
+		return x.Subscribers
 	}
 	return 0
 }
 
-func (x *WeatherData) GetLon() float32 {
+func (x *RegionSubscriptions) GetLingering() int64 {
 	if x != nil {
-		return x.Lon
+		return x.Lingering
 	}
 	return 0
 }
 
-func (x *WeatherData) GetWindKmh() int32 {
+func (x *RegionSubscriptions) GetLocalSubscribers() int32 {
 	if x != nil {
-		return x.WindKmh
+		return x.LocalSubscribers
 	}
 	return 0
 }
 
-func (x *WeatherData) GetTimestamp() string {
+func (x *RegionSubscriptions) GetGeneration() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.Generation
 	}
-	return ""
+	return 0
 }
 
-var File_storm_proto protoreflect.FileDescriptor
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []*RegionSubscriptions `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_storm_proto_rawDesc = "" +
-	"\n" +
-	"\vstorm.proto\x12\vstormhunter\x1a\x1cgoogle/api/annotations.proto\"E\n" +
-	"\x12StartStreamRequest\x12\x16\n" +
-	"\x06region\x18\x01 \x01(\tR\x06region\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xb2\x01\n" +
-	"\vWeatherData\x12\x16\n" +
-	"\x06region\x18\x01 \x01(\tR\x06region\x12\x12\n" +
-	"\x04temp\x18\x02 \x01(\x02R\x04temp\x12\x1a\n" +
-	"\bhumidity\x18\x03 \x01(\x02R\bhumidity\x12\x10\n" +
-	"\x03lat\x18\x04 \x01(\x02R\x03lat\x12\x10\n" +
-	"\x03lon\x18\x05 \x01(\x02R\x03lon\x12\x19\n" +
-	"\bwind_kmh\x18\x06 \x01(\x05R\awindKmh\x12\x1c\n" +
-	"\ttimestamp\x18\a \x01(\tR\ttimestamp2v\n" +
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_storm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSubscriptionsResponse) GetRegions() []*RegionSubscriptions {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type WeatherData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Region         string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Temp           float32                `protobuf:"fixed32,2,opt,name=temp,proto3" json:"temp,omitempty"`
+	Humidity       float32                `protobuf:"fixed32,3,opt,name=humidity,proto3" json:"humidity,omitempty"`
+	Lat            float32                `protobuf:"fixed32,4,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon            float32                `protobuf:"fixed32,5,opt,name=lon,proto3" json:"lon,omitempty"`
+	WindKmh        int32                  `protobuf:"varint,6,opt,name=wind_kmh,json=windKmh,proto3" json:"wind_kmh,omitempty"`
+	Timestamp      string                 `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Heartbeat      bool                   `protobuf:"varint,8,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"` // Повтор последнего замера для поддержания потока, а не новые данные
+	Point          string                 `protobuf:"bytes,9,opt,name=point,proto3" json:"point,omitempty"`          // Точка региона с самым сильным ветром, попавшая в обновление
+	Beaufort       int32                  `protobuf:"varint,10,opt,name=beaufort,proto3" json:"beaufort,omitempty"`  // Балл Бофорта 0–12
+	Category       int32                  `protobuf:"varint,11,opt,name=category,proto3" json:"category,omitempty"`  // Категория Саффира–Симпсона 1–5 для тропических систем, 0 — не ураган
+	Severity       Severity               `protobuf:"varint,12,opt,name=severity,proto3,enum=stormhunter.Severity" json:"severity,omitempty"`
+	Classification string                 `protobuf:"bytes,13,opt,name=classification,proto3" json:"classification,omitempty"` // Например "Gale" или "Category 3 hurricane"
+	Sequence       uint64                 `protobuf:"varint,14,opt,name=sequence,proto3" json:"sequence,omitempty"`            // Номер обновления региона, растёт на 1; передаётся в resume_from
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WeatherData) Reset() {
+	*x = WeatherData{}
+	mi := &file_storm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeatherData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherData) ProtoMessage() {}
+
+func (x *WeatherData) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherData.ProtoReflect.Descriptor instead.
+func (*WeatherData) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WeatherData) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *WeatherData) GetTemp() float32 {
+	if x != nil {
+		return x.Temp
+	}
+	return 0
+}
+
+func (x *WeatherData) GetHumidity() float32 {
+	if x != nil {
+		return x.Humidity
+	}
+	return 0
+}
+
+func (x *WeatherData) GetLat() float32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *WeatherData) GetLon() float32 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *WeatherData) GetWindKmh() int32 {
+	if x != nil {
+		return x.WindKmh
+	}
+	return 0
+}
+
+func (x *WeatherData) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *WeatherData) GetHeartbeat() bool {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return false
+}
+
+func (x *WeatherData) GetPoint() string {
+	if x != nil {
+		return x.Point
+	}
+	return ""
+}
+
+func (x *WeatherData) GetBeaufort() int32 {
+	if x != nil {
+		return x.Beaufort
+	}
+	return 0
+}
+
+func (x *WeatherData) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *WeatherData) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_NONE
+}
+
+func (x *WeatherData) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *WeatherData) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type ListRegionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsRequest) Reset() {
+	*x = ListRegionsRequest{}
+	mi := &file_storm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsRequest) ProtoMessage() {}
+
+func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{7}
+}
+
+type SamplePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Lat           float32                `protobuf:"fixed32,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float32                `protobuf:"fixed32,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SamplePoint) Reset() {
+	*x = SamplePoint{}
+	mi := &file_storm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SamplePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamplePoint) ProtoMessage() {}
+
+func (x *SamplePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamplePoint.ProtoReflect.Descriptor instead.
+func (*SamplePoint) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SamplePoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SamplePoint) GetLat() float32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *SamplePoint) GetLon() float32 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+type Region struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Provider        string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`                                       // Цепочка источников погоды, например "openweather|openmeteo"
+	IntervalSeconds int32                  `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"` // Период опроса
+	Points          []*SamplePoint         `protobuf:"bytes,5,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Region) Reset() {
+	*x = Region{}
+	mi := &file_storm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Region) ProtoMessage() {}
+
+func (x *Region) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Region.ProtoReflect.Descriptor instead.
+func (*Region) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Region) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Region) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Region) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Region) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *Region) GetPoints() []*SamplePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ListRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []*Region              `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsResponse) Reset() {
+	*x = ListRegionsResponse{}
+	mi := &file_storm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsResponse) ProtoMessage() {}
+
+func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListRegionsResponse) GetRegions() []*Region {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type GetObservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // RFC3339, включительно; по умолчанию 48 часов до to
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // RFC3339, не включительно; по умолчанию текущее время
+	Bucket        Bucket                 `protobuf:"varint,4,opt,name=bucket,proto3,enum=stormhunter.Bucket" json:"bucket,omitempty"`
+	Point         string                 `protobuf:"bytes,5,opt,name=point,proto3" json:"point,omitempty"`                          // Необязательный фильтр по точке региона
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // По умолчанию 500, не больше 5000
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token из предыдущего ответа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObservationsRequest) Reset() {
+	*x = GetObservationsRequest{}
+	mi := &file_storm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObservationsRequest) ProtoMessage() {}
+
+func (x *GetObservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObservationsRequest.ProtoReflect.Descriptor instead.
+func (*GetObservationsRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetObservationsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetObservationsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetObservationsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetObservationsRequest) GetBucket() Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return Bucket_BUCKET_RAW
+}
+
+func (x *GetObservationsRequest) GetPoint() string {
+	if x != nil {
+		return x.Point
+	}
+	return ""
+}
+
+func (x *GetObservationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetObservationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type MetricStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           float64                `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Avg           float64                `protobuf:"fixed64,3,opt,name=avg,proto3" json:"avg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricStats) Reset() {
+	*x = MetricStats{}
+	mi := &file_storm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricStats) ProtoMessage() {}
+
+func (x *MetricStats) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricStats.ProtoReflect.Descriptor instead.
+func (*MetricStats) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MetricStats) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MetricStats) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MetricStats) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+type ObservationBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`       // Начало интервала (для raw — время замера), RFC3339
+	Samples       int32                  `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`  // Число замеров в интервале
+	Point         string                 `protobuf:"bytes,3,opt,name=point,proto3" json:"point,omitempty"`       // Только для raw
+	Provider      string                 `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"` // Только для raw
+	Temp          *MetricStats           `protobuf:"bytes,5,opt,name=temp,proto3" json:"temp,omitempty"`
+	Humidity      *MetricStats           `protobuf:"bytes,6,opt,name=humidity,proto3" json:"humidity,omitempty"`
+	WindKmh       *MetricStats           `protobuf:"bytes,7,opt,name=wind_kmh,json=windKmh,proto3" json:"wind_kmh,omitempty"`
+	Pressure      *MetricStats           `protobuf:"bytes,8,opt,name=pressure,proto3" json:"pressure,omitempty"` // Отсутствует, если источники не сообщали давление
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObservationBucket) Reset() {
+	*x = ObservationBucket{}
+	mi := &file_storm_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObservationBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObservationBucket) ProtoMessage() {}
+
+func (x *ObservationBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObservationBucket.ProtoReflect.Descriptor instead.
+func (*ObservationBucket) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ObservationBucket) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ObservationBucket) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *ObservationBucket) GetPoint() string {
+	if x != nil {
+		return x.Point
+	}
+	return ""
+}
+
+func (x *ObservationBucket) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ObservationBucket) GetTemp() *MetricStats {
+	if x != nil {
+		return x.Temp
+	}
+	return nil
+}
+
+func (x *ObservationBucket) GetHumidity() *MetricStats {
+	if x != nil {
+		return x.Humidity
+	}
+	return nil
+}
+
+func (x *ObservationBucket) GetWindKmh() *MetricStats {
+	if x != nil {
+		return x.WindKmh
+	}
+	return nil
+}
+
+func (x *ObservationBucket) GetPressure() *MetricStats {
+	if x != nil {
+		return x.Pressure
+	}
+	return nil
+}
+
+type GetObservationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket        Bucket                 `protobuf:"varint,2,opt,name=bucket,proto3,enum=stormhunter.Bucket" json:"bucket,omitempty"`
+	Buckets       []*ObservationBucket   `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Пусто на последней странице
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObservationsResponse) Reset() {
+	*x = GetObservationsResponse{}
+	mi := &file_storm_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObservationsResponse) ProtoMessage() {}
+
+func (x *GetObservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObservationsResponse.ProtoReflect.Descriptor instead.
+func (*GetObservationsResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetObservationsResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetObservationsResponse) GetBucket() Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return Bucket_BUCKET_RAW
+}
+
+func (x *GetObservationsResponse) GetBuckets() []*ObservationBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetObservationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Storm struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Стабильный идентификатор, например atlantic-20261017T120000Z
+	Region      string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Status      StormStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=stormhunter.StormStatus" json:"status,omitempty"`
+	StartedAt   string                 `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // RFC3339
+	EndedAt     string                 `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`       // RFC3339; пусто, пока шторм продолжается
+	UpdatedAt   string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	Lat         float32                `protobuf:"fixed32,7,opt,name=lat,proto3" json:"lat,omitempty"`                            // Последнее известное положение
+	Lon         float32                `protobuf:"fixed32,8,opt,name=lon,proto3" json:"lon,omitempty"`
+	WindKmh     float32                `protobuf:"fixed32,9,opt,name=wind_kmh,json=windKmh,proto3" json:"wind_kmh,omitempty"` // Последний ветер
+	PeakWindKmh float32                `protobuf:"fixed32,10,opt,name=peak_wind_kmh,json=peakWindKmh,proto3" json:"peak_wind_kmh,omitempty"`
+	PeakAt      string                 `protobuf:"bytes,11,opt,name=peak_at,json=peakAt,proto3" json:"peak_at,omitempty"`                  // RFC3339
+	MinPressure float32                `protobuf:"fixed32,12,opt,name=min_pressure,json=minPressure,proto3" json:"min_pressure,omitempty"` // гПа, 0 — давление не сообщалось
+	Beaufort    int32                  `protobuf:"varint,13,opt,name=beaufort,proto3" json:"beaufort,omitempty"`                           // Классификация пика
+	Category    int32                  `protobuf:"varint,14,opt,name=category,proto3" json:"category,omitempty"`
+	Severity    Severity               `protobuf:"varint,15,opt,name=severity,proto3,enum=stormhunter.Severity" json:"severity,omitempty"`
+	// Прогноз на +1, +3, +6 и +12 ч как GeoJSON FeatureCollection: конус неопределённости (Polygon),
+	// линия прогноза (LineString) и ожидаемые положения (Point). Только в GetStorm и событиях открытых штормов
+	Forecast      *structpb.Struct `protobuf:"bytes,16,opt,name=forecast,proto3" json:"forecast,omitempty"`
+	Name          string           `protobuf:"bytes,17,opt,name=name,proto3" json:"name,omitempty"`     // Имя из архива HURDAT2; у обнаруженных штормов пусто
+	Source        string           `protobuf:"bytes,18,opt,name=source,proto3" json:"source,omitempty"` // live — обнаружен детектором, hurdat2 — импортирован из best track NOAA
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Storm) Reset() {
+	*x = Storm{}
+	mi := &file_storm_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Storm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storm) ProtoMessage() {}
+
+func (x *Storm) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storm.ProtoReflect.Descriptor instead.
+func (*Storm) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Storm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Storm) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Storm) GetStatus() StormStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StormStatus_STORM_STATUS_ANY
+}
+
+func (x *Storm) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Storm) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+func (x *Storm) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Storm) GetLat() float32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Storm) GetLon() float32 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *Storm) GetWindKmh() float32 {
+	if x != nil {
+		return x.WindKmh
+	}
+	return 0
+}
+
+func (x *Storm) GetPeakWindKmh() float32 {
+	if x != nil {
+		return x.PeakWindKmh
+	}
+	return 0
+}
+
+func (x *Storm) GetPeakAt() string {
+	if x != nil {
+		return x.PeakAt
+	}
+	return ""
+}
+
+func (x *Storm) GetMinPressure() float32 {
+	if x != nil {
+		return x.MinPressure
+	}
+	return 0
+}
+
+func (x *Storm) GetBeaufort() int32 {
+	if x != nil {
+		return x.Beaufort
+	}
+	return 0
+}
+
+func (x *Storm) GetCategory() int32 {
+	if x != nil {
+		return x.Category
+	}
+	return 0
+}
+
+func (x *Storm) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_NONE
+}
+
+func (x *Storm) GetForecast() *structpb.Struct {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+func (x *Storm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Storm) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type GetStormRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StormId       string                 `protobuf:"bytes,1,opt,name=storm_id,json=stormId,proto3" json:"storm_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStormRequest) Reset() {
+	*x = GetStormRequest{}
+	mi := &file_storm_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStormRequest) ProtoMessage() {}
+
+func (x *GetStormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStormRequest.ProtoReflect.Descriptor instead.
+func (*GetStormRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetStormRequest) GetStormId() string {
+	if x != nil {
+		return x.StormId
+	}
+	return ""
+}
+
+type ListStormsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        StormStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=stormhunter.StormStatus" json:"status,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                                   // RFC3339; штормы, продолжавшиеся в момент from или позже
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`                                       // RFC3339; штормы, начавшиеся раньше to
+	MinCategory   int32                  `protobuf:"varint,5,opt,name=min_category,json=minCategory,proto3" json:"min_category,omitempty"` // Пиковая категория Саффира–Симпсона не ниже
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`          // По умолчанию 100, не больше 1000
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`                               // live или hurdat2; пусто — все
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStormsRequest) Reset() {
+	*x = ListStormsRequest{}
+	mi := &file_storm_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStormsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStormsRequest) ProtoMessage() {}
+
+func (x *ListStormsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStormsRequest.ProtoReflect.Descriptor instead.
+func (*ListStormsRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListStormsRequest) GetStatus() StormStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StormStatus_STORM_STATUS_ANY
+}
+
+func (x *ListStormsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListStormsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListStormsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListStormsRequest) GetMinCategory() int32 {
+	if x != nil {
+		return x.MinCategory
+	}
+	return 0
+}
+
+func (x *ListStormsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStormsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ListStormsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Storms        []*Storm               `protobuf:"bytes,1,rep,name=storms,proto3" json:"storms,omitempty"` // Сначала самые поздние
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStormsResponse) Reset() {
+	*x = ListStormsResponse{}
+	mi := &file_storm_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStormsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStormsResponse) ProtoMessage() {}
+
+func (x *ListStormsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStormsResponse.ProtoReflect.Descriptor instead.
+func (*ListStormsResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListStormsResponse) GetStorms() []*Storm {
+	if x != nil {
+		return x.Storms
+	}
+	return nil
+}
+
+type GetStormTrackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StormId       string                 `protobuf:"bytes,1,opt,name=storm_id,json=stormId,proto3" json:"storm_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStormTrackRequest) Reset() {
+	*x = GetStormTrackRequest{}
+	mi := &file_storm_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStormTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStormTrackRequest) ProtoMessage() {}
+
+func (x *GetStormTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStormTrackRequest.ProtoReflect.Descriptor instead.
+func (*GetStormTrackRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStormTrackRequest) GetStormId() string {
+	if x != nil {
+		return x.StormId
+	}
+	return ""
+}
+
+type GetStormTrackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GeoJSON Feature: LineString в порядке [lon, lat] (Point для трека из одной точки) и свойства шторма.
+	// Свойства вершин — параллельные массивы в properties.coordinateProperties
+	Feature       *structpb.Struct `protobuf:"bytes,1,opt,name=feature,proto3" json:"feature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStormTrackResponse) Reset() {
+	*x = GetStormTrackResponse{}
+	mi := &file_storm_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStormTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStormTrackResponse) ProtoMessage() {}
+
+func (x *GetStormTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStormTrackResponse.ProtoReflect.Descriptor instead.
+func (*GetStormTrackResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetStormTrackResponse) GetFeature() *structpb.Struct {
+	if x != nil {
+		return x.Feature
+	}
+	return nil
+}
+
+// Например wind_kmh > 90 в Atlantic два обновления подряд или humidity +20 за 30 минут
+type AlertRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Назначается сервером
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Region          string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Metric          AlertMetric            `protobuf:"varint,4,opt,name=metric,proto3,enum=stormhunter.AlertMetric" json:"metric,omitempty"`
+	Operator        AlertOperator          `protobuf:"varint,5,opt,name=operator,proto3,enum=stormhunter.AlertOperator" json:"operator,omitempty"`
+	Value           float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`                                           // Порог или величина изменения для rise/fall
+	ForUpdates      int32                  `protobuf:"varint,7,opt,name=for_updates,json=forUpdates,proto3" json:"for_updates,omitempty"`                // Для порогов: сколько обновлений подряд, по умолчанию 1
+	WindowSeconds   int32                  `protobuf:"varint,8,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`       // Для rise/fall: окно изменения, не больше суток
+	CooldownSeconds int32                  `protobuf:"varint,9,opt,name=cooldown_seconds,json=cooldownSeconds,proto3" json:"cooldown_seconds,omitempty"` // Минимальный интервал между срабатываниями
+	Enabled         bool                   `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`                                       // Только чтение: новые правила включены
+	CreatedAt       string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // RFC3339
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_storm_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AlertRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AlertRule) GetMetric() AlertMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return AlertMetric_ALERT_METRIC_UNSPECIFIED
+}
+
+func (x *AlertRule) GetOperator() AlertOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return AlertOperator_ALERT_OPERATOR_UNSPECIFIED
+}
+
+func (x *AlertRule) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AlertRule) GetForUpdates() int32 {
+	if x != nil {
+		return x.ForUpdates
+	}
+	return 0
+}
+
+func (x *AlertRule) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *AlertRule) GetCooldownSeconds() int32 {
+	if x != nil {
+		return x.CooldownSeconds
+	}
+	return 0
+}
+
+func (x *AlertRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AlertRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AlertEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        int64                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	State         AlertState             `protobuf:"varint,5,opt,name=state,proto3,enum=stormhunter.AlertState" json:"state,omitempty"`
+	Value         float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"` // Значение показателя или его изменение для rise/fall
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Sequence      uint64                 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`                      // Обновление региона, на котором изменилось состояние
+	OccurredAt    string                 `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertEvent) Reset() {
+	*x = AlertEvent{}
+	mi := &file_storm_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertEvent) ProtoMessage() {}
+
+func (x *AlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertEvent.ProtoReflect.Descriptor instead.
+func (*AlertEvent) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AlertEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetState() AlertState {
+	if x != nil {
+		return x.State
+	}
+	return AlertState_ALERT_STATE_UNSPECIFIED
+}
+
+func (x *AlertEvent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type CreateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_storm_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_storm_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{24}
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_storm_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_storm_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteAlertRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_storm_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{27}
+}
+
+type ListAlertEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // По умолчанию 100, не больше 1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertEventsRequest) Reset() {
+	*x = ListAlertEventsRequest{}
+	mi := &file_storm_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertEventsRequest) ProtoMessage() {}
+
+func (x *ListAlertEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertEventsRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAlertEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAlertEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AlertEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Сначала самые поздние
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertEventsResponse) Reset() {
+	*x = ListAlertEventsResponse{}
+	mi := &file_storm_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertEventsResponse) ProtoMessage() {}
+
+func (x *ListAlertEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertEventsResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAlertEventsResponse) GetEvents() []*AlertEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Адрес, на который POST-запросом отправляются оповещения и события штормов.
+// Тело подписывается: X-Storm-Signature = sha256=<hex HMAC-SHA256(secret, "<X-Storm-Timestamp>.<тело>")>
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                               // Назначается сервером
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                              // http:// или https://
+	Alerts        bool                   `protobuf:"varint,3,opt,name=alerts,proto3" json:"alerts,omitempty"`                       // Оповещения по правилам пользователя (alert.firing, alert.resolved)
+	Storms        bool                   `protobuf:"varint,4,opt,name=storms,proto3" json:"storms,omitempty"`                       // Открытие и закрытие штормов (storm.opened, storm.closed)
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                        // Фильтр штормов по региону, пусто — все регионы
+	Secret        string                 `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`                        // Генерируется сервером и возвращается только в ответе CreateWebhook
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	Zones         bool                   `protobuf:"varint,8,opt,name=zones,proto3" json:"zones,omitempty"`                         // Вход и выход из зон наблюдения пользователя (zone.entered, zone.exited)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_storm_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Webhook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetAlerts() bool {
+	if x != nil {
+		return x.Alerts
+	}
+	return false
+}
+
+func (x *Webhook) GetStorms() bool {
+	if x != nil {
+		return x.Storms
+	}
+	return false
+}
+
+func (x *Webhook) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Webhook) GetZones() bool {
+	if x != nil {
+		return x.Zones
+	}
+	return false
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_storm_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateWebhookRequest) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_storm_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{32}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_storm_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_storm_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_storm_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{35}
+}
+
+type LatLon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatLon) Reset() {
+	*x = LatLon{}
+	mi := &file_storm_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatLon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatLon) ProtoMessage() {}
+
+func (x *LatLon) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatLon.ProtoReflect.Descriptor instead.
+func (*LatLon) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LatLon) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *LatLon) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+// Зона наблюдения: круг (center и radius_km) или многоугольник (polygon, без повтора первой вершины).
+// Событие приходит, когда в зону входит или из неё выходит шторм или точка самого сильного ветра региона
+type WatchZone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Назначается сервером
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Center        *LatLon                `protobuf:"bytes,3,opt,name=center,proto3" json:"center,omitempty"`                               // Центр круга
+	RadiusKm      float64                `protobuf:"fixed64,4,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`         // Радиус круга, не больше 2000 км
+	Polygon       []*LatLon              `protobuf:"bytes,5,rep,name=polygon,proto3" json:"polygon,omitempty"`                             // От 3 до 100 вершин, не шире 180 градусов долготы
+	MinWindKmh    float32                `protobuf:"fixed32,6,opt,name=min_wind_kmh,json=minWindKmh,proto3" json:"min_wind_kmh,omitempty"` // Для обновлений регионов: минимальный ветер в точке, 0 — любой
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`        // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchZone) Reset() {
+	*x = WatchZone{}
+	mi := &file_storm_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchZone) ProtoMessage() {}
+
+func (x *WatchZone) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchZone.ProtoReflect.Descriptor instead.
+func (*WatchZone) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WatchZone) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchZone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchZone) GetCenter() *LatLon {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *WatchZone) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *WatchZone) GetPolygon() []*LatLon {
+	if x != nil {
+		return x.Polygon
+	}
+	return nil
+}
+
+func (x *WatchZone) GetMinWindKmh() float32 {
+	if x != nil {
+		return x.MinWindKmh
+	}
+	return 0
+}
+
+func (x *WatchZone) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WatchZoneEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneId        int64                  `protobuf:"varint,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	ZoneName      string                 `protobuf:"bytes,2,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`                    // enter или exit
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                  // storm или region
+	StormId       string                 `protobuf:"bytes,5,opt,name=storm_id,json=stormId,proto3" json:"storm_id,omitempty"` // Для source = storm
+	Region        string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	Position      *LatLon                `protobuf:"bytes,7,opt,name=position,proto3" json:"position,omitempty"`
+	WindKmh       float32                `protobuf:"fixed32,8,opt,name=wind_kmh,json=windKmh,proto3" json:"wind_kmh,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchZoneEvent) Reset() {
+	*x = WatchZoneEvent{}
+	mi := &file_storm_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchZoneEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchZoneEvent) ProtoMessage() {}
+
+func (x *WatchZoneEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchZoneEvent.ProtoReflect.Descriptor instead.
+func (*WatchZoneEvent) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WatchZoneEvent) GetZoneId() int64 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+func (x *WatchZoneEvent) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *WatchZoneEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WatchZoneEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *WatchZoneEvent) GetStormId() string {
+	if x != nil {
+		return x.StormId
+	}
+	return ""
+}
+
+func (x *WatchZoneEvent) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *WatchZoneEvent) GetPosition() *LatLon {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *WatchZoneEvent) GetWindKmh() float32 {
+	if x != nil {
+		return x.WindKmh
+	}
+	return 0
+}
+
+func (x *WatchZoneEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type CreateWatchZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *WatchZone             `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWatchZoneRequest) Reset() {
+	*x = CreateWatchZoneRequest{}
+	mi := &file_storm_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWatchZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWatchZoneRequest) ProtoMessage() {}
+
+func (x *CreateWatchZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWatchZoneRequest.ProtoReflect.Descriptor instead.
+func (*CreateWatchZoneRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateWatchZoneRequest) GetZone() *WatchZone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type ListWatchZonesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchZonesRequest) Reset() {
+	*x = ListWatchZonesRequest{}
+	mi := &file_storm_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchZonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchZonesRequest) ProtoMessage() {}
+
+func (x *ListWatchZonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchZonesRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchZonesRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{40}
+}
+
+type ListWatchZonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zones         []*WatchZone           `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchZonesResponse) Reset() {
+	*x = ListWatchZonesResponse{}
+	mi := &file_storm_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchZonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchZonesResponse) ProtoMessage() {}
+
+func (x *ListWatchZonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchZonesResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchZonesResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListWatchZonesResponse) GetZones() []*WatchZone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+type DeleteWatchZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWatchZoneRequest) Reset() {
+	*x = DeleteWatchZoneRequest{}
+	mi := &file_storm_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWatchZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWatchZoneRequest) ProtoMessage() {}
+
+func (x *DeleteWatchZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWatchZoneRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWatchZoneRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteWatchZoneRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWatchZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWatchZoneResponse) Reset() {
+	*x = DeleteWatchZoneResponse{}
+	mi := &file_storm_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWatchZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWatchZoneResponse) ProtoMessage() {}
+
+func (x *DeleteWatchZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWatchZoneResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWatchZoneResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{43}
+}
+
+type PlanInterceptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StormId       string                 `protobuf:"bytes,1,opt,name=storm_id,json=stormId,proto3" json:"storm_id,omitempty"`
+	Position      *LatLon                `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`                           // Положение охотника (GPS)
+	SpeedKmh      float64                `protobuf:"fixed64,3,opt,name=speed_kmh,json=speedKmh,proto3" json:"speed_kmh,omitempty"`         // Средняя скорость охотника, по умолчанию 80, не больше 300
+	TrackPoints   int32                  `protobuf:"varint,4,opt,name=track_points,json=trackPoints,proto3" json:"track_points,omitempty"` // Последних точек трека для оценки движения шторма, по умолчанию 6, от 2 до 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanInterceptRequest) Reset() {
+	*x = PlanInterceptRequest{}
+	mi := &file_storm_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanInterceptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanInterceptRequest) ProtoMessage() {}
+
+func (x *PlanInterceptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanInterceptRequest.ProtoReflect.Descriptor instead.
+func (*PlanInterceptRequest) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PlanInterceptRequest) GetStormId() string {
+	if x != nil {
+		return x.StormId
+	}
+	return ""
+}
+
+func (x *PlanInterceptRequest) GetPosition() *LatLon {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PlanInterceptRequest) GetSpeedKmh() float64 {
+	if x != nil {
+		return x.SpeedKmh
+	}
+	return 0
+}
+
+func (x *PlanInterceptRequest) GetTrackPoints() int32 {
+	if x != nil {
+		return x.TrackPoints
+	}
+	return 0
+}
+
+type StormMotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpeedKmh      float64                `protobuf:"fixed64,1,opt,name=speed_kmh,json=speedKmh,proto3" json:"speed_kmh,omitempty"`       // 0, если точек мало или шторм стоит на месте
+	HeadingDeg    float64                `protobuf:"fixed64,2,opt,name=heading_deg,json=headingDeg,proto3" json:"heading_deg,omitempty"` // Азимут движения, градусы от севера по часовой стрелке
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`                            // Сколько точек трека вошло в оценку
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StormMotion) Reset() {
+	*x = StormMotion{}
+	mi := &file_storm_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StormMotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StormMotion) ProtoMessage() {}
+
+func (x *StormMotion) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StormMotion.ProtoReflect.Descriptor instead.
+func (*StormMotion) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StormMotion) GetSpeedKmh() float64 {
+	if x != nil {
+		return x.SpeedKmh
+	}
+	return 0
+}
+
+func (x *StormMotion) GetHeadingDeg() float64 {
+	if x != nil {
+		return x.HeadingDeg
+	}
+	return 0
+}
+
+func (x *StormMotion) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type PlanInterceptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StormId       string                 `protobuf:"bytes,1,opt,name=storm_id,json=stormId,proto3" json:"storm_id,omitempty"`
+	StormPosition *LatLon                `protobuf:"bytes,2,opt,name=storm_position,json=stormPosition,proto3" json:"storm_position,omitempty"` // Последнее известное положение шторма
+	ObservedAt    string                 `protobuf:"bytes,3,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`          // RFC3339, когда шторм был там
+	DistanceKm    float64                `protobuf:"fixed64,4,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`        // Расстояние по большому кругу до storm_position
+	BearingDeg    float64                `protobuf:"fixed64,5,opt,name=bearing_deg,json=bearingDeg,proto3" json:"bearing_deg,omitempty"`        // Начальный азимут на storm_position
+	Motion        *StormMotion           `protobuf:"bytes,6,opt,name=motion,proto3" json:"motion,omitempty"`
+	Reachable     bool                   `protobuf:"varint,7,opt,name=reachable,proto3" json:"reachable,omitempty"`                       // false, если охотник не догоняет шторм за 72 часа
+	Intercept     *LatLon                `protobuf:"bytes,8,opt,name=intercept,proto3" json:"intercept,omitempty"`                        // Точка встречи при движении шторма по прямой с той же скоростью
+	InterceptAt   string                 `protobuf:"bytes,9,opt,name=intercept_at,json=interceptAt,proto3" json:"intercept_at,omitempty"` // RFC3339
+	TravelKm      float64                `protobuf:"fixed64,10,opt,name=travel_km,json=travelKm,proto3" json:"travel_km,omitempty"`       // Путь охотника до точки встречи
+	HeadingDeg    float64                `protobuf:"fixed64,11,opt,name=heading_deg,json=headingDeg,proto3" json:"heading_deg,omitempty"` // Начальный азимут на точку встречи
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanInterceptResponse) Reset() {
+	*x = PlanInterceptResponse{}
+	mi := &file_storm_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanInterceptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanInterceptResponse) ProtoMessage() {}
+
+func (x *PlanInterceptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storm_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanInterceptResponse.ProtoReflect.Descriptor instead.
+func (*PlanInterceptResponse) Descriptor() ([]byte, []int) {
+	return file_storm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PlanInterceptResponse) GetStormId() string {
+	if x != nil {
+		return x.StormId
+	}
+	return ""
+}
+
+func (x *PlanInterceptResponse) GetStormPosition() *LatLon {
+	if x != nil {
+		return x.StormPosition
+	}
+	return nil
+}
+
+func (x *PlanInterceptResponse) GetObservedAt() string {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return ""
+}
+
+func (x *PlanInterceptResponse) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *PlanInterceptResponse) GetBearingDeg() float64 {
+	if x != nil {
+		return x.BearingDeg
+	}
+	return 0
+}
+
+func (x *PlanInterceptResponse) GetMotion() *StormMotion {
+	if x != nil {
+		return x.Motion
+	}
+	return nil
+}
+
+func (x *PlanInterceptResponse) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *PlanInterceptResponse) GetIntercept() *LatLon {
+	if x != nil {
+		return x.Intercept
+	}
+	return nil
+}
+
+func (x *PlanInterceptResponse) GetInterceptAt() string {
+	if x != nil {
+		return x.InterceptAt
+	}
+	return ""
+}
+
+func (x *PlanInterceptResponse) GetTravelKm() float64 {
+	if x != nil {
+		return x.TravelKm
+	}
+	return 0
+}
+
+func (x *PlanInterceptResponse) GetHeadingDeg() float64 {
+	if x != nil {
+		return x.HeadingDeg
+	}
+	return 0
+}
+
+var File_storm_proto protoreflect.FileDescriptor
+
+const file_storm_proto_rawDesc = "" +
+	"\n" +
+	"\vstorm.proto\x12\vstormhunter\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\"f\n" +
+	"\x12StartStreamRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vresume_from\x18\x03 \x01(\x04R\n" +
+	"resumeFrom\"+\n" +
+	"\x11StopStreamRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\"\x7f\n" +
+	"\x12StopStreamResponse\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12'\n" +
+	"\x0fstopped_streams\x18\x02 \x01(\x05R\x0estoppedStreams\x12(\n" +
+	"\x10no_local_streams\x18\x03 \x01(\bR\x0enoLocalStreams\"\x1a\n" +
+	"\x18ListSubscriptionsRequest\"\xba\x01\n" +
+	"\x13RegionSubscriptions\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12 \n" +
+	"\vsubscribers\x18\x02 \x01(\x03R\vsubscribers\x12\x1c\n" +
+	"\tlingering\x18\x03 \x01(\x03R\tlingering\x12+\n" +
+	"\x11local_subscribers\x18\x04 \x01(\x05R\x10localSubscribers\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x05 \x01(\x03R\n" +
+	"generation\"W\n" +
+	"\x19ListSubscriptionsResponse\x12:\n" +
+	"\aregions\x18\x01 \x03(\v2 .stormhunter.RegionSubscriptionsR\aregions\"\x95\x03\n" +
+	"\vWeatherData\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x12\n" +
+	"\x04temp\x18\x02 \x01(\x02R\x04temp\x12\x1a\n" +
+	"\bhumidity\x18\x03 \x01(\x02R\bhumidity\x12\x10\n" +
+	"\x03lat\x18\x04 \x01(\x02R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x05 \x01(\x02R\x03lon\x12\x19\n" +
+	"\bwind_kmh\x18\x06 \x01(\x05R\awindKmh\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\tR\ttimestamp\x12\x1c\n" +
+	"\theartbeat\x18\b \x01(\bR\theartbeat\x12\x14\n" +
+	"\x05point\x18\t \x01(\tR\x05point\x12\x1a\n" +
+	"\bbeaufort\x18\n" +
+	" \x01(\x05R\bbeaufort\x12\x1a\n" +
+	"\bcategory\x18\v \x01(\x05R\bcategory\x121\n" +
+	"\bseverity\x18\f \x01(\x0e2\x15.stormhunter.SeverityR\bseverity\x12&\n" +
+	"\x0eclassification\x18\r \x01(\tR\x0eclassification\x12\x1a\n" +
+	"\bsequence\x18\x0e \x01(\x04R\bsequence\"\x14\n" +
+	"\x12ListRegionsRequest\"E\n" +
+	"\vSamplePoint\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03lat\x18\x02 \x01(\x02R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x03 \x01(\x02R\x03lon\"\xa5\x01\n" +
+	"\x06Region\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12)\n" +
+	"\x10interval_seconds\x18\x04 \x01(\x05R\x0fintervalSeconds\x120\n" +
+	"\x06points\x18\x05 \x03(\v2\x18.stormhunter.SamplePointR\x06points\"D\n" +
+	"\x13ListRegionsResponse\x12-\n" +
+	"\aregions\x18\x01 \x03(\v2\x13.stormhunter.RegionR\aregions\"\xd3\x01\n" +
+	"\x16GetObservationsRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12+\n" +
+	"\x06bucket\x18\x04 \x01(\x0e2\x13.stormhunter.BucketR\x06bucket\x12\x14\n" +
+	"\x05point\x18\x05 \x01(\tR\x05point\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"C\n" +
+	"\vMetricStats\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x01R\x03max\x12\x10\n" +
+	"\x03avg\x18\x03 \x01(\x01R\x03avg\"\xc4\x02\n" +
+	"\x11ObservationBucket\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x18\n" +
+	"\asamples\x18\x02 \x01(\x05R\asamples\x12\x14\n" +
+	"\x05point\x18\x03 \x01(\tR\x05point\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12,\n" +
+	"\x04temp\x18\x05 \x01(\v2\x18.stormhunter.MetricStatsR\x04temp\x124\n" +
+	"\bhumidity\x18\x06 \x01(\v2\x18.stormhunter.MetricStatsR\bhumidity\x123\n" +
+	"\bwind_kmh\x18\a \x01(\v2\x18.stormhunter.MetricStatsR\awindKmh\x124\n" +
+	"\bpressure\x18\b \x01(\v2\x18.stormhunter.MetricStatsR\bpressure\"\xc0\x01\n" +
+	"\x17GetObservationsResponse\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12+\n" +
+	"\x06bucket\x18\x02 \x01(\x0e2\x13.stormhunter.BucketR\x06bucket\x128\n" +
+	"\abuckets\x18\x03 \x03(\v2\x1e.stormhunter.ObservationBucketR\abuckets\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xa5\x04\n" +
+	"\x05Storm\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.stormhunter.StormStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\x05 \x01(\tR\aendedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12\x10\n" +
+	"\x03lat\x18\a \x01(\x02R\x03lat\x12\x10\n" +
+	"\x03lon\x18\b \x01(\x02R\x03lon\x12\x19\n" +
+	"\bwind_kmh\x18\t \x01(\x02R\awindKmh\x12\"\n" +
+	"\rpeak_wind_kmh\x18\n" +
+	" \x01(\x02R\vpeakWindKmh\x12\x17\n" +
+	"\apeak_at\x18\v \x01(\tR\x06peakAt\x12!\n" +
+	"\fmin_pressure\x18\f \x01(\x02R\vminPressure\x12\x1a\n" +
+	"\bbeaufort\x18\r \x01(\x05R\bbeaufort\x12\x1a\n" +
+	"\bcategory\x18\x0e \x01(\x05R\bcategory\x121\n" +
+	"\bseverity\x18\x0f \x01(\x0e2\x15.stormhunter.SeverityR\bseverity\x123\n" +
+	"\bforecast\x18\x10 \x01(\v2\x17.google.protobuf.StructR\bforecast\x12\x12\n" +
+	"\x04name\x18\x11 \x01(\tR\x04name\x12\x16\n" +
+	"\x06source\x18\x12 \x01(\tR\x06source\",\n" +
+	"\x0fGetStormRequest\x12\x19\n" +
+	"\bstorm_id\x18\x01 \x01(\tR\astormId\"\xd9\x01\n" +
+	"\x11ListStormsRequest\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.stormhunter.StormStatusR\x06status\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12!\n" +
+	"\fmin_category\x18\x05 \x01(\x05R\vminCategory\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\"@\n" +
+	"\x12ListStormsResponse\x12*\n" +
+	"\x06storms\x18\x01 \x03(\v2\x12.stormhunter.StormR\x06storms\"1\n" +
+	"\x14GetStormTrackRequest\x12\x19\n" +
+	"\bstorm_id\x18\x01 \x01(\tR\astormId\"J\n" +
+	"\x15GetStormTrackResponse\x121\n" +
+	"\afeature\x18\x01 \x01(\v2\x17.google.protobuf.StructR\afeature\"\xf3\x02\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x120\n" +
+	"\x06metric\x18\x04 \x01(\x0e2\x18.stormhunter.AlertMetricR\x06metric\x126\n" +
+	"\boperator\x18\x05 \x01(\x0e2\x1a.stormhunter.AlertOperatorR\boperator\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\x12\x1f\n" +
+	"\vfor_updates\x18\a \x01(\x05R\n" +
+	"forUpdates\x12%\n" +
+	"\x0ewindow_seconds\x18\b \x01(\x05R\rwindowSeconds\x12)\n" +
+	"\x10cooldown_seconds\x18\t \x01(\x05R\x0fcooldownSeconds\x12\x18\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"\x86\x02\n" +
+	"\n" +
+	"AlertEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x03R\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x03 \x01(\tR\bruleName\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12-\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x17.stormhunter.AlertStateR\x05state\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x1a\n" +
+	"\bsequence\x18\b \x01(\x04R\bsequence\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\tR\n" +
+	"occurredAt\"D\n" +
+	"\x16CreateAlertRuleRequest\x12*\n" +
+	"\x04rule\x18\x01 \x01(\v2\x16.stormhunter.AlertRuleR\x04rule\"\x17\n" +
+	"\x15ListAlertRulesRequest\"F\n" +
+	"\x16ListAlertRulesResponse\x12,\n" +
+	"\x05rules\x18\x01 \x03(\v2\x16.stormhunter.AlertRuleR\x05rules\"(\n" +
+	"\x16DeleteAlertRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
+	"\x17DeleteAlertRuleResponse\"5\n" +
+	"\x16ListAlertEventsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\"J\n" +
+	"\x17ListAlertEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.stormhunter.AlertEventR\x06events\"\xc0\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06alerts\x18\x03 \x01(\bR\x06alerts\x12\x16\n" +
+	"\x06storms\x18\x04 \x01(\bR\x06storms\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x16\n" +
+	"\x06secret\x18\x06 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x14\n" +
+	"\x05zones\x18\b \x01(\bR\x05zones\"F\n" +
+	"\x14CreateWebhookRequest\x12.\n" +
+	"\awebhook\x18\x01 \x01(\v2\x14.stormhunter.WebhookR\awebhook\"\x15\n" +
+	"\x13ListWebhooksRequest\"H\n" +
+	"\x14ListWebhooksResponse\x120\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x14.stormhunter.WebhookR\bwebhooks\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
+	"\x15DeleteWebhookResponse\",\n" +
+	"\x06LatLon\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\"\xe9\x01\n" +
+	"\tWatchZone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x06center\x18\x03 \x01(\v2\x13.stormhunter.LatLonR\x06center\x12\x1b\n" +
+	"\tradius_km\x18\x04 \x01(\x01R\bradiusKm\x12-\n" +
+	"\apolygon\x18\x05 \x03(\v2\x13.stormhunter.LatLonR\apolygon\x12 \n" +
+	"\fmin_wind_kmh\x18\x06 \x01(\x02R\n" +
+	"minWindKmh\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\x94\x02\n" +
+	"\x0eWatchZoneEvent\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\x03R\x06zoneId\x12\x1b\n" +
+	"\tzone_name\x18\x02 \x01(\tR\bzoneName\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x19\n" +
+	"\bstorm_id\x18\x05 \x01(\tR\astormId\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12/\n" +
+	"\bposition\x18\a \x01(\v2\x13.stormhunter.LatLonR\bposition\x12\x19\n" +
+	"\bwind_kmh\x18\b \x01(\x02R\awindKmh\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\tR\n" +
+	"occurredAt\"D\n" +
+	"\x16CreateWatchZoneRequest\x12*\n" +
+	"\x04zone\x18\x01 \x01(\v2\x16.stormhunter.WatchZoneR\x04zone\"\x17\n" +
+	"\x15ListWatchZonesRequest\"F\n" +
+	"\x16ListWatchZonesResponse\x12,\n" +
+	"\x05zones\x18\x01 \x03(\v2\x16.stormhunter.WatchZoneR\x05zones\"(\n" +
+	"\x16DeleteWatchZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
+	"\x17DeleteWatchZoneResponse\"\xa2\x01\n" +
+	"\x14PlanInterceptRequest\x12\x19\n" +
+	"\bstorm_id\x18\x01 \x01(\tR\astormId\x12/\n" +
+	"\bposition\x18\x02 \x01(\v2\x13.stormhunter.LatLonR\bposition\x12\x1b\n" +
+	"\tspeed_kmh\x18\x03 \x01(\x01R\bspeedKmh\x12!\n" +
+	"\ftrack_points\x18\x04 \x01(\x05R\vtrackPoints\"c\n" +
+	"\vStormMotion\x12\x1b\n" +
+	"\tspeed_kmh\x18\x01 \x01(\x01R\bspeedKmh\x12\x1f\n" +
+	"\vheading_deg\x18\x02 \x01(\x01R\n" +
+	"headingDeg\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\"\xb5\x03\n" +
+	"\x15PlanInterceptResponse\x12\x19\n" +
+	"\bstorm_id\x18\x01 \x01(\tR\astormId\x12:\n" +
+	"\x0estorm_position\x18\x02 \x01(\v2\x13.stormhunter.LatLonR\rstormPosition\x12\x1f\n" +
+	"\vobserved_at\x18\x03 \x01(\tR\n" +
+	"observedAt\x12\x1f\n" +
+	"\vdistance_km\x18\x04 \x01(\x01R\n" +
+	"distanceKm\x12\x1f\n" +
+	"\vbearing_deg\x18\x05 \x01(\x01R\n" +
+	"bearingDeg\x120\n" +
+	"\x06motion\x18\x06 \x01(\v2\x18.stormhunter.StormMotionR\x06motion\x12\x1c\n" +
+	"\treachable\x18\a \x01(\bR\treachable\x121\n" +
+	"\tintercept\x18\b \x01(\v2\x13.stormhunter.LatLonR\tintercept\x12!\n" +
+	"\fintercept_at\x18\t \x01(\tR\vinterceptAt\x12\x1b\n" +
+	"\ttravel_km\x18\n" +
+	" \x01(\x01R\btravelKm\x12\x1f\n" +
+	"\vheading_deg\x18\v \x01(\x01R\n" +
+	"headingDeg*o\n" +
+	"\bSeverity\x12\x11\n" +
+	"\rSEVERITY_NONE\x10\x00\x12\x10\n" +
+	"\fSEVERITY_LOW\x10\x01\x12\x15\n" +
+	"\x11SEVERITY_MODERATE\x10\x02\x12\x11\n" +
+	"\rSEVERITY_HIGH\x10\x03\x12\x14\n" +
+	"\x10SEVERITY_EXTREME\x10\x04*F\n" +
+	"\x06Bucket\x12\x0e\n" +
+	"\n" +
+	"BUCKET_RAW\x10\x00\x12\r\n" +
+	"\tBUCKET_1M\x10\x01\x12\x0e\n" +
+	"\n" +
+	"BUCKET_10M\x10\x02\x12\r\n" +
+	"\tBUCKET_1H\x10\x03*U\n" +
+	"\vStormStatus\x12\x14\n" +
+	"\x10STORM_STATUS_ANY\x10\x00\x12\x17\n" +
+	"\x13STORM_STATUS_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13STORM_STATUS_CLOSED\x10\x02*\x93\x01\n" +
+	"\vAlertMetric\x12\x1c\n" +
+	"\x18ALERT_METRIC_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ALERT_METRIC_WIND_KMH\x10\x01\x12\x15\n" +
+	"\x11ALERT_METRIC_TEMP\x10\x02\x12\x19\n" +
+	"\x15ALERT_METRIC_HUMIDITY\x10\x03\x12\x19\n" +
+	"\x15ALERT_METRIC_PRESSURE\x10\x04*\xbf\x01\n" +
+	"\rAlertOperator\x12\x1e\n" +
+	"\x1aALERT_OPERATOR_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ALERT_OPERATOR_GT\x10\x01\x12\x16\n" +
+	"\x12ALERT_OPERATOR_GTE\x10\x02\x12\x15\n" +
+	"\x11ALERT_OPERATOR_LT\x10\x03\x12\x16\n" +
+	"\x12ALERT_OPERATOR_LTE\x10\x04\x12\x17\n" +
+	"\x13ALERT_OPERATOR_RISE\x10\x05\x12\x17\n" +
+	"\x13ALERT_OPERATOR_FALL\x10\x06*[\n" +
+	"\n" +
+	"AlertState\x12\x1b\n" +
+	"\x17ALERT_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ALERT_STATE_FIRING\x10\x01\x12\x18\n" +
+	"\x14ALERT_STATE_RESOLVED\x10\x022\x8e\x11\n" +
 	"\fStormService\x12f\n" +
-	"\vStartStream\x12\x1f.stormhunter.StartStreamRequest\x1a\x18.stormhunter.WeatherData\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/storm/start0\x01B Z\x1eStorm-Hunt/storm-backend/protob\x06proto3"
+	"\vStartStream\x12\x1f.stormhunter.StartStreamRequest\x1a\x18.stormhunter.WeatherData\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/storm/start0\x01\x12h\n" +
+	"\n" +
+	"StopStream\x12\x1e.stormhunter.StopStreamRequest\x1a\x1f.stormhunter.StopStreamResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/storm/stop\x12e\n" +
+	"\vListRegions\x12\x1f.stormhunter.ListRegionsRequest\x1a .stormhunter.ListRegionsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/regions\x12\x87\x01\n" +
+	"\x0fGetObservations\x12#.stormhunter.GetObservationsRequest\x1a$.stormhunter.GetObservationsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/regions/{region}/observations\x12a\n" +
+	"\n" +
+	"ListStorms\x12\x1e.stormhunter.ListStormsRequest\x1a\x1f.stormhunter.ListStormsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/storms\x12[\n" +
+	"\bGetStorm\x12\x1c.stormhunter.GetStormRequest\x1a\x12.stormhunter.Storm\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/storms/{storm_id}\x12\x84\x01\n" +
+	"\rGetStormTrack\x12!.stormhunter.GetStormTrackRequest\x1a\".stormhunter.GetStormTrackResponse\",\x82\xd3\xe4\x93\x02&b\afeature\x12\x1b/v1/storms/{storm_id}/track\x12\x7f\n" +
+	"\rPlanIntercept\x12!.stormhunter.PlanInterceptRequest\x1a\".stormhunter.PlanInterceptResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/storms/{storm_id}/intercept\x12n\n" +
+	"\x0fCreateAlertRule\x12#.stormhunter.CreateAlertRuleRequest\x1a\x16.stormhunter.AlertRule\"\x1e\x82\xd3\xe4\x93\x02\x18:\x04rule\"\x10/v1/alerts/rules\x12s\n" +
+	"\x0eListAlertRules\x12\".stormhunter.ListAlertRulesRequest\x1a#.stormhunter.ListAlertRulesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/alerts/rules\x12{\n" +
+	"\x0fDeleteAlertRule\x12#.stormhunter.DeleteAlertRuleRequest\x1a$.stormhunter.DeleteAlertRuleResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/alerts/rules/{id}\x12w\n" +
+	"\x0fListAlertEvents\x12#.stormhunter.ListAlertEventsRequest\x1a$.stormhunter.ListAlertEventsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/alerts/events\x12g\n" +
+	"\rCreateWebhook\x12!.stormhunter.CreateWebhookRequest\x1a\x14.stormhunter.Webhook\"\x1d\x82\xd3\xe4\x93\x02\x17:\awebhook\"\f/v1/webhooks\x12i\n" +
+	"\fListWebhooks\x12 .stormhunter.ListWebhooksRequest\x1a!.stormhunter.ListWebhooksResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/webhooks\x12q\n" +
+	"\rDeleteWebhook\x12!.stormhunter.DeleteWebhookRequest\x1a\".stormhunter.DeleteWebhookResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/webhooks/{id}\x12g\n" +
+	"\x0fCreateWatchZone\x12#.stormhunter.CreateWatchZoneRequest\x1a\x16.stormhunter.WatchZone\"\x17\x82\xd3\xe4\x93\x02\x11:\x04zone\"\t/v1/zones\x12l\n" +
+	"\x0eListWatchZones\x12\".stormhunter.ListWatchZonesRequest\x1a#.stormhunter.ListWatchZonesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/zones\x12t\n" +
+	"\x0fDeleteWatchZone\x12#.stormhunter.DeleteWatchZoneRequest\x1a$.stormhunter.DeleteWatchZoneResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/zones/{id}\x12\x83\x01\n" +
+	"\x11ListSubscriptions\x12%.stormhunter.ListSubscriptionsRequest\x1a&.stormhunter.ListSubscriptionsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/debug/subscriptionsB Z\x1eStorm-Hunt/storm-backend/protob\x06proto3"
 
 var (
 	file_storm_proto_rawDescOnce sync.Once
@@ -197,19 +3658,142 @@ func file_storm_proto_rawDescGZIP() []byte {
 	return file_storm_proto_rawDescData
 }
 
-var file_storm_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_storm_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_storm_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_storm_proto_goTypes = []any{
-	(*StartStreamRequest)(nil), // 0: stormhunter.StartStreamRequest
-	(*WeatherData)(nil),        // 1: stormhunter.WeatherData
+	(Severity)(0),                     // 0: stormhunter.Severity
+	(Bucket)(0),                       // 1: stormhunter.Bucket
+	(StormStatus)(0),                  // 2: stormhunter.StormStatus
+	(AlertMetric)(0),                  // 3: stormhunter.AlertMetric
+	(AlertOperator)(0),                // 4: stormhunter.AlertOperator
+	(AlertState)(0),                   // 5: stormhunter.AlertState
+	(*StartStreamRequest)(nil),        // 6: stormhunter.StartStreamRequest
+	(*StopStreamRequest)(nil),         // 7: stormhunter.StopStreamRequest
+	(*StopStreamResponse)(nil),        // 8: stormhunter.StopStreamResponse
+	(*ListSubscriptionsRequest)(nil),  // 9: stormhunter.ListSubscriptionsRequest
+	(*RegionSubscriptions)(nil),       // 10: stormhunter.RegionSubscriptions
+	(*ListSubscriptionsResponse)(nil), // 11: stormhunter.ListSubscriptionsResponse
+	(*WeatherData)(nil),               // 12: stormhunter.WeatherData
+	(*ListRegionsRequest)(nil),        // 13: stormhunter.ListRegionsRequest
+	(*SamplePoint)(nil),               // 14: stormhunter.SamplePoint
+	(*Region)(nil),                    // 15: stormhunter.Region
+	(*ListRegionsResponse)(nil),       // 16: stormhunter.ListRegionsResponse
+	(*GetObservationsRequest)(nil),    // 17: stormhunter.GetObservationsRequest
+	(*MetricStats)(nil),               // 18: stormhunter.MetricStats
+	(*ObservationBucket)(nil),         // 19: stormhunter.ObservationBucket
+	(*GetObservationsResponse)(nil),   // 20: stormhunter.GetObservationsResponse
+	(*Storm)(nil),                     // 21: stormhunter.Storm
+	(*GetStormRequest)(nil),           // 22: stormhunter.GetStormRequest
+	(*ListStormsRequest)(nil),         // 23: stormhunter.ListStormsRequest
+	(*ListStormsResponse)(nil),        // 24: stormhunter.ListStormsResponse
+	(*GetStormTrackRequest)(nil),      // 25: stormhunter.GetStormTrackRequest
+	(*GetStormTrackResponse)(nil),     // 26: stormhunter.GetStormTrackResponse
+	(*AlertRule)(nil),                 // 27: stormhunter.AlertRule
+	(*AlertEvent)(nil),                // 28: stormhunter.AlertEvent
+	(*CreateAlertRuleRequest)(nil),    // 29: stormhunter.CreateAlertRuleRequest
+	(*ListAlertRulesRequest)(nil),     // 30: stormhunter.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),    // 31: stormhunter.ListAlertRulesResponse
+	(*DeleteAlertRuleRequest)(nil),    // 32: stormhunter.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),   // 33: stormhunter.DeleteAlertRuleResponse
+	(*ListAlertEventsRequest)(nil),    // 34: stormhunter.ListAlertEventsRequest
+	(*ListAlertEventsResponse)(nil),   // 35: stormhunter.ListAlertEventsResponse
+	(*Webhook)(nil),                   // 36: stormhunter.Webhook
+	(*CreateWebhookRequest)(nil),      // 37: stormhunter.CreateWebhookRequest
+	(*ListWebhooksRequest)(nil),       // 38: stormhunter.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),      // 39: stormhunter.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),      // 40: stormhunter.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),     // 41: stormhunter.DeleteWebhookResponse
+	(*LatLon)(nil),                    // 42: stormhunter.LatLon
+	(*WatchZone)(nil),                 // 43: stormhunter.WatchZone
+	(*WatchZoneEvent)(nil),            // 44: stormhunter.WatchZoneEvent
+	(*CreateWatchZoneRequest)(nil),    // 45: stormhunter.CreateWatchZoneRequest
+	(*ListWatchZonesRequest)(nil),     // 46: stormhunter.ListWatchZonesRequest
+	(*ListWatchZonesResponse)(nil),    // 47: stormhunter.ListWatchZonesResponse
+	(*DeleteWatchZoneRequest)(nil),    // 48: stormhunter.DeleteWatchZoneRequest
+	(*DeleteWatchZoneResponse)(nil),   // 49: stormhunter.DeleteWatchZoneResponse
+	(*PlanInterceptRequest)(nil),      // 50: stormhunter.PlanInterceptRequest
+	(*StormMotion)(nil),               // 51: stormhunter.StormMotion
+	(*PlanInterceptResponse)(nil),     // 52: stormhunter.PlanInterceptResponse
+	(*structpb.Struct)(nil),           // 53: google.protobuf.Struct
 }
 var file_storm_proto_depIdxs = []int32{
-	0, // 0: stormhunter.StormService.StartStream:input_type -> stormhunter.StartStreamRequest
-	1, // 1: stormhunter.StormService.StartStream:output_type -> stormhunter.WeatherData
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	10, // 0: stormhunter.ListSubscriptionsResponse.regions:type_name -> stormhunter.RegionSubscriptions
+	0,  // 1: stormhunter.WeatherData.severity:type_name -> stormhunter.Severity
+	14, // 2: stormhunter.Region.points:type_name -> stormhunter.SamplePoint
+	15, // 3: stormhunter.ListRegionsResponse.regions:type_name -> stormhunter.Region
+	1,  // 4: stormhunter.GetObservationsRequest.bucket:type_name -> stormhunter.Bucket
+	18, // 5: stormhunter.ObservationBucket.temp:type_name -> stormhunter.MetricStats
+	18, // 6: stormhunter.ObservationBucket.humidity:type_name -> stormhunter.MetricStats
+	18, // 7: stormhunter.ObservationBucket.wind_kmh:type_name -> stormhunter.MetricStats
+	18, // 8: stormhunter.ObservationBucket.pressure:type_name -> stormhunter.MetricStats
+	1,  // 9: stormhunter.GetObservationsResponse.bucket:type_name -> stormhunter.Bucket
+	19, // 10: stormhunter.GetObservationsResponse.buckets:type_name -> stormhunter.ObservationBucket
+	2,  // 11: stormhunter.Storm.status:type_name -> stormhunter.StormStatus
+	0,  // 12: stormhunter.Storm.severity:type_name -> stormhunter.Severity
+	53, // 13: stormhunter.Storm.forecast:type_name -> google.protobuf.Struct
+	2,  // 14: stormhunter.ListStormsRequest.status:type_name -> stormhunter.StormStatus
+	21, // 15: stormhunter.ListStormsResponse.storms:type_name -> stormhunter.Storm
+	53, // 16: stormhunter.GetStormTrackResponse.feature:type_name -> google.protobuf.Struct
+	3,  // 17: stormhunter.AlertRule.metric:type_name -> stormhunter.AlertMetric
+	4,  // 18: stormhunter.AlertRule.operator:type_name -> stormhunter.AlertOperator
+	5,  // 19: stormhunter.AlertEvent.state:type_name -> stormhunter.AlertState
+	27, // 20: stormhunter.CreateAlertRuleRequest.rule:type_name -> stormhunter.AlertRule
+	27, // 21: stormhunter.ListAlertRulesResponse.rules:type_name -> stormhunter.AlertRule
+	28, // 22: stormhunter.ListAlertEventsResponse.events:type_name -> stormhunter.AlertEvent
+	36, // 23: stormhunter.CreateWebhookRequest.webhook:type_name -> stormhunter.Webhook
+	36, // 24: stormhunter.ListWebhooksResponse.webhooks:type_name -> stormhunter.Webhook
+	42, // 25: stormhunter.WatchZone.center:type_name -> stormhunter.LatLon
+	42, // 26: stormhunter.WatchZone.polygon:type_name -> stormhunter.LatLon
+	42, // 27: stormhunter.WatchZoneEvent.position:type_name -> stormhunter.LatLon
+	43, // 28: stormhunter.CreateWatchZoneRequest.zone:type_name -> stormhunter.WatchZone
+	43, // 29: stormhunter.ListWatchZonesResponse.zones:type_name -> stormhunter.WatchZone
+	42, // 30: stormhunter.PlanInterceptRequest.position:type_name -> stormhunter.LatLon
+	42, // 31: stormhunter.PlanInterceptResponse.storm_position:type_name -> stormhunter.LatLon
+	51, // 32: stormhunter.PlanInterceptResponse.motion:type_name -> stormhunter.StormMotion
+	42, // 33: stormhunter.PlanInterceptResponse.intercept:type_name -> stormhunter.LatLon
+	6,  // 34: stormhunter.StormService.StartStream:input_type -> stormhunter.StartStreamRequest
+	7,  // 35: stormhunter.StormService.StopStream:input_type -> stormhunter.StopStreamRequest
+	13, // 36: stormhunter.StormService.ListRegions:input_type -> stormhunter.ListRegionsRequest
+	17, // 37: stormhunter.StormService.GetObservations:input_type -> stormhunter.GetObservationsRequest
+	23, // 38: stormhunter.StormService.ListStorms:input_type -> stormhunter.ListStormsRequest
+	22, // 39: stormhunter.StormService.GetStorm:input_type -> stormhunter.GetStormRequest
+	25, // 40: stormhunter.StormService.GetStormTrack:input_type -> stormhunter.GetStormTrackRequest
+	50, // 41: stormhunter.StormService.PlanIntercept:input_type -> stormhunter.PlanInterceptRequest
+	29, // 42: stormhunter.StormService.CreateAlertRule:input_type -> stormhunter.CreateAlertRuleRequest
+	30, // 43: stormhunter.StormService.ListAlertRules:input_type -> stormhunter.ListAlertRulesRequest
+	32, // 44: stormhunter.StormService.DeleteAlertRule:input_type -> stormhunter.DeleteAlertRuleRequest
+	34, // 45: stormhunter.StormService.ListAlertEvents:input_type -> stormhunter.ListAlertEventsRequest
+	37, // 46: stormhunter.StormService.CreateWebhook:input_type -> stormhunter.CreateWebhookRequest
+	38, // 47: stormhunter.StormService.ListWebhooks:input_type -> stormhunter.ListWebhooksRequest
+	40, // 48: stormhunter.StormService.DeleteWebhook:input_type -> stormhunter.DeleteWebhookRequest
+	45, // 49: stormhunter.StormService.CreateWatchZone:input_type -> stormhunter.CreateWatchZoneRequest
+	46, // 50: stormhunter.StormService.ListWatchZones:input_type -> stormhunter.ListWatchZonesRequest
+	48, // 51: stormhunter.StormService.DeleteWatchZone:input_type -> stormhunter.DeleteWatchZoneRequest
+	9,  // 52: stormhunter.StormService.ListSubscriptions:input_type -> stormhunter.ListSubscriptionsRequest
+	12, // 53: stormhunter.StormService.StartStream:output_type -> stormhunter.WeatherData
+	8,  // 54: stormhunter.StormService.StopStream:output_type -> stormhunter.StopStreamResponse
+	16, // 55: stormhunter.StormService.ListRegions:output_type -> stormhunter.ListRegionsResponse
+	20, // 56: stormhunter.StormService.GetObservations:output_type -> stormhunter.GetObservationsResponse
+	24, // 57: stormhunter.StormService.ListStorms:output_type -> stormhunter.ListStormsResponse
+	21, // 58: stormhunter.StormService.GetStorm:output_type -> stormhunter.Storm
+	26, // 59: stormhunter.StormService.GetStormTrack:output_type -> stormhunter.GetStormTrackResponse
+	52, // 60: stormhunter.StormService.PlanIntercept:output_type -> stormhunter.PlanInterceptResponse
+	27, // 61: stormhunter.StormService.CreateAlertRule:output_type -> stormhunter.AlertRule
+	31, // 62: stormhunter.StormService.ListAlertRules:output_type -> stormhunter.ListAlertRulesResponse
+	33, // 63: stormhunter.StormService.DeleteAlertRule:output_type -> stormhunter.DeleteAlertRuleResponse
+	35, // 64: stormhunter.StormService.ListAlertEvents:output_type -> stormhunter.ListAlertEventsResponse
+	36, // 65: stormhunter.StormService.CreateWebhook:output_type -> stormhunter.Webhook
+	39, // 66: stormhunter.StormService.ListWebhooks:output_type -> stormhunter.ListWebhooksResponse
+	41, // 67: stormhunter.StormService.DeleteWebhook:output_type -> stormhunter.DeleteWebhookResponse
+	43, // 68: stormhunter.StormService.CreateWatchZone:output_type -> stormhunter.WatchZone
+	47, // 69: stormhunter.StormService.ListWatchZones:output_type -> stormhunter.ListWatchZonesResponse
+	49, // 70: stormhunter.StormService.DeleteWatchZone:output_type -> stormhunter.DeleteWatchZoneResponse
+	11, // 71: stormhunter.StormService.ListSubscriptions:output_type -> stormhunter.ListSubscriptionsResponse
+	53, // [53:72] is the sub-list for method output_type
+	34, // [34:53] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_storm_proto_init() }
@@ -222,13 +3806,14 @@ func file_storm_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storm_proto_rawDesc), len(file_storm_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      6,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_storm_proto_goTypes,
 		DependencyIndexes: file_storm_proto_depIdxs,
+		EnumInfos:         file_storm_proto_enumTypes,
 		MessageInfos:      file_storm_proto_msgTypes,
 	}.Build()
 	File_storm_proto = out.File
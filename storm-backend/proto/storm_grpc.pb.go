@@ -19,7 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StormService_StartStream_FullMethodName = "/stormhunter.StormService/StartStream"
+	StormService_StartStream_FullMethodName       = "/stormhunter.StormService/StartStream"
+	StormService_StopStream_FullMethodName        = "/stormhunter.StormService/StopStream"
+	StormService_ListRegions_FullMethodName       = "/stormhunter.StormService/ListRegions"
+	StormService_GetObservations_FullMethodName   = "/stormhunter.StormService/GetObservations"
+	StormService_ListStorms_FullMethodName        = "/stormhunter.StormService/ListStorms"
+	StormService_GetStorm_FullMethodName          = "/stormhunter.StormService/GetStorm"
+	StormService_GetStormTrack_FullMethodName     = "/stormhunter.StormService/GetStormTrack"
+	StormService_PlanIntercept_FullMethodName     = "/stormhunter.StormService/PlanIntercept"
+	StormService_CreateAlertRule_FullMethodName   = "/stormhunter.StormService/CreateAlertRule"
+	StormService_ListAlertRules_FullMethodName    = "/stormhunter.StormService/ListAlertRules"
+	StormService_DeleteAlertRule_FullMethodName   = "/stormhunter.StormService/DeleteAlertRule"
+	StormService_ListAlertEvents_FullMethodName   = "/stormhunter.StormService/ListAlertEvents"
+	StormService_CreateWebhook_FullMethodName     = "/stormhunter.StormService/CreateWebhook"
+	StormService_ListWebhooks_FullMethodName      = "/stormhunter.StormService/ListWebhooks"
+	StormService_DeleteWebhook_FullMethodName     = "/stormhunter.StormService/DeleteWebhook"
+	StormService_CreateWatchZone_FullMethodName   = "/stormhunter.StormService/CreateWatchZone"
+	StormService_ListWatchZones_FullMethodName    = "/stormhunter.StormService/ListWatchZones"
+	StormService_DeleteWatchZone_FullMethodName   = "/stormhunter.StormService/DeleteWatchZone"
+	StormService_ListSubscriptions_FullMethodName = "/stormhunter.StormService/ListSubscriptions"
 )
 
 // StormServiceClient is the client API for StormService service.
@@ -27,6 +45,30 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StormServiceClient interface {
 	StartStream(ctx context.Context, in *StartStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WeatherData], error)
+	StopStream(ctx context.Context, in *StopStreamRequest, opts ...grpc.CallOption) (*StopStreamResponse, error)
+	ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error)
+	GetObservations(ctx context.Context, in *GetObservationsRequest, opts ...grpc.CallOption) (*GetObservationsResponse, error)
+	ListStorms(ctx context.Context, in *ListStormsRequest, opts ...grpc.CallOption) (*ListStormsResponse, error)
+	// Карточка шторма; у открытого шторма с прогнозом движения
+	GetStorm(ctx context.Context, in *GetStormRequest, opts ...grpc.CallOption) (*Storm, error)
+	// Трек шторма как GeoJSON Feature; REST отдаёт сам Feature
+	GetStormTrack(ctx context.Context, in *GetStormTrackRequest, opts ...grpc.CallOption) (*GetStormTrackResponse, error)
+	// Расстояние, азимут и точка встречи охотника с открытым штормом
+	PlanIntercept(ctx context.Context, in *PlanInterceptRequest, opts ...grpc.CallOption) (*PlanInterceptResponse, error)
+	// Правила оповещений текущего пользователя
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*AlertRule, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	// Журнал срабатываний и снятий оповещений текущего пользователя
+	ListAlertEvents(ctx context.Context, in *ListAlertEventsRequest, opts ...grpc.CallOption) (*ListAlertEventsResponse, error)
+	// Адреса исходящих уведомлений текущего пользователя
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	CreateWatchZone(ctx context.Context, in *CreateWatchZoneRequest, opts ...grpc.CallOption) (*WatchZone, error)
+	ListWatchZones(ctx context.Context, in *ListWatchZonesRequest, opts ...grpc.CallOption) (*ListWatchZonesResponse, error)
+	DeleteWatchZone(ctx context.Context, in *DeleteWatchZoneRequest, opts ...grpc.CallOption) (*DeleteWatchZoneResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
 }
 
 type stormServiceClient struct {
@@ -56,11 +98,215 @@ func (c *stormServiceClient) StartStream(ctx context.Context, in *StartStreamReq
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StormService_StartStreamClient = grpc.ServerStreamingClient[WeatherData]
 
+func (c *stormServiceClient) StopStream(ctx context.Context, in *StopStreamRequest, opts ...grpc.CallOption) (*StopStreamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopStreamResponse)
+	err := c.cc.Invoke(ctx, StormService_StopStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRegionsResponse)
+	err := c.cc.Invoke(ctx, StormService_ListRegions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) GetObservations(ctx context.Context, in *GetObservationsRequest, opts ...grpc.CallOption) (*GetObservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObservationsResponse)
+	err := c.cc.Invoke(ctx, StormService_GetObservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) ListStorms(ctx context.Context, in *ListStormsRequest, opts ...grpc.CallOption) (*ListStormsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStormsResponse)
+	err := c.cc.Invoke(ctx, StormService_ListStorms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) GetStorm(ctx context.Context, in *GetStormRequest, opts ...grpc.CallOption) (*Storm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Storm)
+	err := c.cc.Invoke(ctx, StormService_GetStorm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) GetStormTrack(ctx context.Context, in *GetStormTrackRequest, opts ...grpc.CallOption) (*GetStormTrackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStormTrackResponse)
+	err := c.cc.Invoke(ctx, StormService_GetStormTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) PlanIntercept(ctx context.Context, in *PlanInterceptRequest, opts ...grpc.CallOption) (*PlanInterceptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanInterceptResponse)
+	err := c.cc.Invoke(ctx, StormService_PlanIntercept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*AlertRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRule)
+	err := c.cc.Invoke(ctx, StormService_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, StormService_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, StormService_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) ListAlertEvents(ctx context.Context, in *ListAlertEventsRequest, opts ...grpc.CallOption) (*ListAlertEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertEventsResponse)
+	err := c.cc.Invoke(ctx, StormService_ListAlertEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, StormService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, StormService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, StormService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) CreateWatchZone(ctx context.Context, in *CreateWatchZoneRequest, opts ...grpc.CallOption) (*WatchZone, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchZone)
+	err := c.cc.Invoke(ctx, StormService_CreateWatchZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) ListWatchZones(ctx context.Context, in *ListWatchZonesRequest, opts ...grpc.CallOption) (*ListWatchZonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWatchZonesResponse)
+	err := c.cc.Invoke(ctx, StormService_ListWatchZones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) DeleteWatchZone(ctx context.Context, in *DeleteWatchZoneRequest, opts ...grpc.CallOption) (*DeleteWatchZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWatchZoneResponse)
+	err := c.cc.Invoke(ctx, StormService_DeleteWatchZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stormServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, StormService_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StormServiceServer is the server API for StormService service.
 // All implementations must embed UnimplementedStormServiceServer
 // for forward compatibility.
 type StormServiceServer interface {
 	StartStream(*StartStreamRequest, grpc.ServerStreamingServer[WeatherData]) error
+	StopStream(context.Context, *StopStreamRequest) (*StopStreamResponse, error)
+	ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error)
+	GetObservations(context.Context, *GetObservationsRequest) (*GetObservationsResponse, error)
+	ListStorms(context.Context, *ListStormsRequest) (*ListStormsResponse, error)
+	// Карточка шторма; у открытого шторма с прогнозом движения
+	GetStorm(context.Context, *GetStormRequest) (*Storm, error)
+	// Трек шторма как GeoJSON Feature; REST отдаёт сам Feature
+	GetStormTrack(context.Context, *GetStormTrackRequest) (*GetStormTrackResponse, error)
+	// Расстояние, азимут и точка встречи охотника с открытым штормом
+	PlanIntercept(context.Context, *PlanInterceptRequest) (*PlanInterceptResponse, error)
+	// Правила оповещений текущего пользователя
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*AlertRule, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	// Журнал срабатываний и снятий оповещений текущего пользователя
+	ListAlertEvents(context.Context, *ListAlertEventsRequest) (*ListAlertEventsResponse, error)
+	// Адреса исходящих уведомлений текущего пользователя
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	CreateWatchZone(context.Context, *CreateWatchZoneRequest) (*WatchZone, error)
+	ListWatchZones(context.Context, *ListWatchZonesRequest) (*ListWatchZonesResponse, error)
+	DeleteWatchZone(context.Context, *DeleteWatchZoneRequest) (*DeleteWatchZoneResponse, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
 	mustEmbedUnimplementedStormServiceServer()
 }
 
@@ -74,6 +320,60 @@ type UnimplementedStormServiceServer struct{}
 func (UnimplementedStormServiceServer) StartStream(*StartStreamRequest, grpc.ServerStreamingServer[WeatherData]) error {
 	return status.Errorf(codes.Unimplemented, "method StartStream not implemented")
 }
+func (UnimplementedStormServiceServer) StopStream(context.Context, *StopStreamRequest) (*StopStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopStream not implemented")
+}
+func (UnimplementedStormServiceServer) ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegions not implemented")
+}
+func (UnimplementedStormServiceServer) GetObservations(context.Context, *GetObservationsRequest) (*GetObservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObservations not implemented")
+}
+func (UnimplementedStormServiceServer) ListStorms(context.Context, *ListStormsRequest) (*ListStormsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorms not implemented")
+}
+func (UnimplementedStormServiceServer) GetStorm(context.Context, *GetStormRequest) (*Storm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorm not implemented")
+}
+func (UnimplementedStormServiceServer) GetStormTrack(context.Context, *GetStormTrackRequest) (*GetStormTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStormTrack not implemented")
+}
+func (UnimplementedStormServiceServer) PlanIntercept(context.Context, *PlanInterceptRequest) (*PlanInterceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanIntercept not implemented")
+}
+func (UnimplementedStormServiceServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*AlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedStormServiceServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedStormServiceServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedStormServiceServer) ListAlertEvents(context.Context, *ListAlertEventsRequest) (*ListAlertEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertEvents not implemented")
+}
+func (UnimplementedStormServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedStormServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedStormServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedStormServiceServer) CreateWatchZone(context.Context, *CreateWatchZoneRequest) (*WatchZone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWatchZone not implemented")
+}
+func (UnimplementedStormServiceServer) ListWatchZones(context.Context, *ListWatchZonesRequest) (*ListWatchZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchZones not implemented")
+}
+func (UnimplementedStormServiceServer) DeleteWatchZone(context.Context, *DeleteWatchZoneRequest) (*DeleteWatchZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWatchZone not implemented")
+}
+func (UnimplementedStormServiceServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
 func (UnimplementedStormServiceServer) mustEmbedUnimplementedStormServiceServer() {}
 func (UnimplementedStormServiceServer) testEmbeddedByValue()                      {}
 
@@ -106,13 +406,410 @@ func _StormService_StartStream_Handler(srv interface{}, stream grpc.ServerStream
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StormService_StartStreamServer = grpc.ServerStreamingServer[WeatherData]
 
+func _StormService_StopStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).StopStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_StopStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).StopStream(ctx, req.(*StopStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_ListRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).ListRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_ListRegions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).ListRegions(ctx, req.(*ListRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_GetObservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).GetObservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_GetObservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).GetObservations(ctx, req.(*GetObservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_ListStorms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStormsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).ListStorms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_ListStorms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).ListStorms(ctx, req.(*ListStormsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_GetStorm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).GetStorm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_GetStorm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).GetStorm(ctx, req.(*GetStormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_GetStormTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStormTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).GetStormTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_GetStormTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).GetStormTrack(ctx, req.(*GetStormTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_PlanIntercept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanInterceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).PlanIntercept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_PlanIntercept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).PlanIntercept(ctx, req.(*PlanInterceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_ListAlertEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).ListAlertEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_ListAlertEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).ListAlertEvents(ctx, req.(*ListAlertEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_CreateWatchZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWatchZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).CreateWatchZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_CreateWatchZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).CreateWatchZone(ctx, req.(*CreateWatchZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_ListWatchZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).ListWatchZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_ListWatchZones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).ListWatchZones(ctx, req.(*ListWatchZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_DeleteWatchZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWatchZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).DeleteWatchZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_DeleteWatchZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).DeleteWatchZone(ctx, req.(*DeleteWatchZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StormService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StormServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StormService_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StormServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StormService_ServiceDesc is the grpc.ServiceDesc for StormService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var StormService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "stormhunter.StormService",
 	HandlerType: (*StormServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StopStream",
+			Handler:    _StormService_StopStream_Handler,
+		},
+		{
+			MethodName: "ListRegions",
+			Handler:    _StormService_ListRegions_Handler,
+		},
+		{
+			MethodName: "GetObservations",
+			Handler:    _StormService_GetObservations_Handler,
+		},
+		{
+			MethodName: "ListStorms",
+			Handler:    _StormService_ListStorms_Handler,
+		},
+		{
+			MethodName: "GetStorm",
+			Handler:    _StormService_GetStorm_Handler,
+		},
+		{
+			MethodName: "GetStormTrack",
+			Handler:    _StormService_GetStormTrack_Handler,
+		},
+		{
+			MethodName: "PlanIntercept",
+			Handler:    _StormService_PlanIntercept_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _StormService_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _StormService_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _StormService_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertEvents",
+			Handler:    _StormService_ListAlertEvents_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _StormService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _StormService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _StormService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "CreateWatchZone",
+			Handler:    _StormService_CreateWatchZone_Handler,
+		},
+		{
+			MethodName: "ListWatchZones",
+			Handler:    _StormService_ListWatchZones_Handler,
+		},
+		{
+			MethodName: "DeleteWatchZone",
+			Handler:    _StormService_DeleteWatchZone_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _StormService_ListSubscriptions_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "StartStream",
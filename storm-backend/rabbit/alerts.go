@@ -0,0 +1,226 @@
+package rabbit
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"Storm-Hunt/storm-backend/alerts"
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/keycloak"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/proto"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxAlertRules         = 50 // Правил у одного пользователя
+	defaultAlertsPageSize = 100
+	maxAlertsPageSize     = 1000
+)
+
+var alertMetrics = map[proto.AlertMetric]string{
+	proto.AlertMetric_ALERT_METRIC_WIND_KMH: models.MetricWindKmH,
+	proto.AlertMetric_ALERT_METRIC_TEMP:     models.MetricTemp,
+	proto.AlertMetric_ALERT_METRIC_HUMIDITY: models.MetricHumidity,
+	proto.AlertMetric_ALERT_METRIC_PRESSURE: models.MetricPressure,
+}
+
+var alertOperators = map[proto.AlertOperator]string{
+	proto.AlertOperator_ALERT_OPERATOR_GT:   models.OperatorGT,
+	proto.AlertOperator_ALERT_OPERATOR_GTE:  models.OperatorGTE,
+	proto.AlertOperator_ALERT_OPERATOR_LT:   models.OperatorLT,
+	proto.AlertOperator_ALERT_OPERATOR_LTE:  models.OperatorLTE,
+	proto.AlertOperator_ALERT_OPERATOR_RISE: models.OperatorRise,
+	proto.AlertOperator_ALERT_OPERATOR_FALL: models.OperatorFall,
+}
+
+var alertStates = map[string]proto.AlertState{
+	models.AlertFiring:   proto.AlertState_ALERT_STATE_FIRING,
+	models.AlertResolved: proto.AlertState_ALERT_STATE_RESOLVED,
+}
+
+// CreateAlertRule сохраняет правило текущего пользователя; движок подхватит его на следующем обновлении региона
+func (s *StormServer) CreateAlertRule(ctx context.Context, req *proto.CreateAlertRuleRequest) (*proto.AlertRule, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	in := req.GetRule()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+	if _, ok := s.Regions.Get(in.Region); !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown region %q", in.Region)
+	}
+	metric, ok := alertMetrics[in.Metric]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "metric is required")
+	}
+	operator, ok := alertOperators[in.Operator]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "operator is required")
+	}
+
+	rule := &models.AlertRule{
+		UserID:     claims.Subject,
+		Name:       strings.TrimSpace(in.Name),
+		Region:     in.Region,
+		Metric:     metric,
+		Operator:   operator,
+		Value:      in.Value,
+		ForUpdates: int(in.ForUpdates),
+		Window:     time.Duration(in.WindowSeconds) * time.Second,
+		Cooldown:   time.Duration(in.CooldownSeconds) * time.Second,
+		Enabled:    true,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if len(rule.Name) > 200 {
+		return nil, status.Error(codes.InvalidArgument, "name must be at most 200 characters")
+	}
+	if in.ForUpdates < 0 || in.WindowSeconds < 0 || in.CooldownSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "for_updates, window_seconds and cooldown_seconds must not be negative")
+	}
+	if rule.ForUpdates == 0 {
+		rule.ForUpdates = 1
+	}
+	if operator == models.OperatorRise || operator == models.OperatorFall {
+		if rule.Window <= 0 || rule.Window > alerts.MaxWindow {
+			return nil, status.Errorf(codes.InvalidArgument, "window_seconds must be between 1 and %d for %s", int(alerts.MaxWindow.Seconds()), operator)
+		}
+		if rule.Value <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "value must be positive for %s", operator)
+		}
+		rule.ForUpdates = 1 // Изменение за окно уже сглаживает единичные выбросы
+	} else if rule.Window != 0 {
+		return nil, status.Error(codes.InvalidArgument, "window_seconds applies only to rise and fall")
+	}
+	if rule.Name == "" {
+		rule.Name = defaultRuleName(rule)
+	}
+
+	existing, err := s.DB.ListAlertRules(ctx, claims.Subject)
+	if err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to list alert rules")
+		return nil, status.Error(codes.Internal, "failed to create alert rule")
+	}
+	if len(existing) >= maxAlertRules {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d alert rules per user", maxAlertRules)
+	}
+	if err := s.DB.CreateAlertRule(ctx, rule); err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to create alert rule")
+		return nil, status.Error(codes.Internal, "failed to create alert rule")
+	}
+	log.Info().Int64("rule", rule.ID).Str("user", claims.Subject).Str("region", rule.Region).Msg("Alert rule created")
+	return alertRuleToProto(rule), nil
+}
+
+// ListAlertRules возвращает правила текущего пользователя
+func (s *StormServer) ListAlertRules(ctx context.Context, req *proto.ListAlertRulesRequest) (*proto.ListAlertRulesResponse, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	rules, err := s.DB.ListAlertRules(ctx, claims.Subject)
+	if err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to list alert rules")
+		return nil, status.Error(codes.Internal, "failed to list alert rules")
+	}
+	resp := &proto.ListAlertRulesResponse{}
+	for i := range rules {
+		resp.Rules = append(resp.Rules, alertRuleToProto(&rules[i]))
+	}
+	return resp, nil
+}
+
+// DeleteAlertRule удаляет правило текущего пользователя; чужие правила неотличимы от несуществующих
+func (s *StormServer) DeleteAlertRule(ctx context.Context, req *proto.DeleteAlertRuleRequest) (*proto.DeleteAlertRuleResponse, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	err := s.DB.DeleteAlertRule(ctx, claims.Subject, req.Id)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "unknown alert rule %d", req.Id)
+	}
+	if err != nil {
+		log.Error().Err(err).Int64("rule", req.Id).Msg("Failed to delete alert rule")
+		return nil, status.Error(codes.Internal, "failed to delete alert rule")
+	}
+	log.Info().Int64("rule", req.Id).Str("user", claims.Subject).Msg("Alert rule deleted")
+	return &proto.DeleteAlertRuleResponse{}, nil
+}
+
+// ListAlertEvents возвращает оповещения текущего пользователя, сначала самые поздние
+func (s *StormServer) ListAlertEvents(ctx context.Context, req *proto.ListAlertEventsRequest) (*proto.ListAlertEventsResponse, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = defaultAlertsPageSize
+	}
+	if limit > maxAlertsPageSize {
+		limit = maxAlertsPageSize
+	}
+	events, err := s.DB.ListAlertEvents(ctx, claims.Subject, limit)
+	if err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to list alert events")
+		return nil, status.Error(codes.Internal, "failed to list alert events")
+	}
+	resp := &proto.ListAlertEventsResponse{}
+	for i := range events {
+		resp.Events = append(resp.Events, alertEventToProto(&events[i]))
+	}
+	return resp, nil
+}
+
+// Имя по условию, например "wind_kmh gt 90 in Atlantic"
+func defaultRuleName(rule *models.AlertRule) string {
+	return rule.Metric + " " + rule.Operator + " " + strconv.FormatFloat(rule.Value, 'f', -1, 64) + " in " + rule.Region
+}
+
+func alertRuleToProto(rule *models.AlertRule) *proto.AlertRule {
+	out := &proto.AlertRule{
+		Id:              rule.ID,
+		Name:            rule.Name,
+		Region:          rule.Region,
+		Value:           rule.Value,
+		ForUpdates:      int32(rule.ForUpdates),
+		WindowSeconds:   int32(rule.Window / time.Second),
+		CooldownSeconds: int32(rule.Cooldown / time.Second),
+		Enabled:         rule.Enabled,
+		CreatedAt:       rule.CreatedAt.Format(time.RFC3339),
+	}
+	for value, name := range alertMetrics {
+		if name == rule.Metric {
+			out.Metric = value
+		}
+	}
+	for value, name := range alertOperators {
+		if name == rule.Operator {
+			out.Operator = value
+		}
+	}
+	return out
+}
+
+func alertEventToProto(event *models.AlertEvent) *proto.AlertEvent {
+	return &proto.AlertEvent{
+		Id:         event.ID,
+		RuleId:     event.RuleID,
+		RuleName:   event.RuleName,
+		Region:     event.Region,
+		State:      alertStates[event.State],
+		Value:      event.Value,
+		Message:    event.Message,
+		Sequence:   event.Sequence,
+		OccurredAt: event.OccurredAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,91 @@
+package rabbit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"Storm-Hunt/storm-backend/models"
+
+	"github.com/rs/zerolog/log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	TasksQueue      = "weather_tasks"   // Очередь задач на запуск опроса (забирает один воркер)
+	ControlExchange = "weather_control" // Fanout-обменник для команд остановки (получают все воркеры)
+)
+
+// Активный поток StartStream, который можно закрыть через StopStream
+type activeStream struct {
+	userID string
+	cancel context.CancelFunc
+}
+
+// Регистрация потока; возвращает функцию снятия регистрации
+func (s *StormServer) trackStream(region string, stream *activeStream) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.streams == nil {
+		s.streams = make(map[string]map[*activeStream]struct{})
+	}
+	if s.streams[region] == nil {
+		s.streams[region] = make(map[*activeStream]struct{})
+	}
+	s.streams[region][stream] = struct{}{}
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.streams[region], stream)
+		if len(s.streams[region]) == 0 {
+			delete(s.streams, region)
+		}
+	}
+}
+
+// Отмена всех потоков пользователя по региону; возвращает число отменённых и оставшихся потоков
+func (s *StormServer) cancelUserStreams(region, userID string) (cancelled, remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for stream := range s.streams[region] {
+		if stream.userID == userID {
+			stream.cancel()
+			cancelled++
+		}
+	}
+	return cancelled, len(s.streams[region]) - cancelled
+}
+
+// Публикация задачи на запуск опроса региона (реализация subscription.Poller)
+func (s *StormServer) StartPolling(region, userID string, generation int64) error {
+	body, err := json.Marshal(models.WeatherTask{Region: region, UserID: userID, Action: models.TaskActionStart, Generation: generation})
+	if err != nil {
+		return fmt.Errorf("failed to marshal start task: %w", err)
+	}
+	if err := s.AMQPChan.Publish("", TasksQueue, false, false, amqp.Publishing{
+		ContentType: "application/json",
+		Body:        body,
+	}); err != nil {
+		return fmt.Errorf("failed to publish start task for %s: %w", region, err)
+	}
+	log.Debug().Str("region", region).Int64("generation", generation).Msg("Published weather task to RabbitMQ")
+	return nil
+}
+
+// Рассылка всем воркерам команды остановить опрос региона (реализация subscription.Poller)
+func (s *StormServer) StopPolling(region string, generation int64) error {
+	body, err := json.Marshal(models.WeatherTask{Region: region, Action: models.TaskActionStop, Generation: generation})
+	if err != nil {
+		return fmt.Errorf("failed to marshal stop task: %w", err)
+	}
+	if err := s.AMQPChan.Publish(ControlExchange, "", false, false, amqp.Publishing{
+		ContentType: "application/json",
+		Body:        body,
+	}); err != nil {
+		return fmt.Errorf("failed to publish stop command for %s: %w", region, err)
+	}
+	log.Info().Str("region", region).Int64("generation", generation).Msg("Published stop command to RabbitMQ")
+	return nil
+}
@@ -0,0 +1,155 @@
+package rabbit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/export"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Самый длинный интервал выгрузки замеров: сырые замеры всех точек региона за больший срок
+// выгружаются по частям, как GetObservations отдаёт их страницами
+const maxExportWindow = 7 * 24 * time.Hour
+
+// ServeStormExport отдаёт трек шторма файлом: GET /v1/export/storms/{storm_id}?format=geojson|kml|csv
+func (s *StormServer) ServeStormExport(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("storm_id")
+	s.serveExport(w, r, export.Storms, id, "Storm "+id, func(ctx context.Context, out export.Writer) error {
+		return export.Storm(ctx, s.DB, id, out)
+	})
+}
+
+// ServeActiveStormsExport отдаёт открытые штормы с треками: GET /v1/export/storms?region=Atlantic&format=kml.
+// Без region выгружаются штормы всех регионов
+func (s *StormServer) ServeActiveStormsExport(w http.ResponseWriter, r *http.Request) {
+	region := r.URL.Query().Get("region")
+	name, title := "active-storms", "Active storms"
+	if region != "" {
+		if _, ok := s.Regions.Get(region); !ok {
+			http.Error(w, fmt.Sprintf("unknown region %q", region), http.StatusNotFound)
+			return
+		}
+		name, title = region+"-active-storms", "Active storms, "+region
+	}
+	s.serveExport(w, r, export.Storms, name, title, func(ctx context.Context, out export.Writer) error {
+		return export.ActiveStorms(ctx, s.DB, region, out)
+	})
+}
+
+// ServeObservationsExport отдаёт сырые замеры региона за интервал:
+// GET /v1/export/observations?region=Atlantic&from=...&to=...&point=...&format=csv.
+// Интервал по умолчанию тот же, что у GetObservations: 48 часов до to или до текущего момента; не длиннее maxExportWindow
+func (s *StormServer) ServeObservationsExport(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	region := params.Get("region")
+	if region == "" {
+		http.Error(w, "region parameter is required", http.StatusBadRequest)
+		return
+	}
+	if _, ok := s.Regions.Get(region); !ok {
+		http.Error(w, fmt.Sprintf("unknown region %q", region), http.StatusNotFound)
+		return
+	}
+	to := time.Now().UTC()
+	if raw := params.Get("to"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid to: %v", err), http.StatusBadRequest)
+			return
+		}
+		to = parsed
+	}
+	from := to.Add(-defaultHistoryWindow)
+	if raw := params.Get("from"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid from: %v", err), http.StatusBadRequest)
+			return
+		}
+		from = parsed
+	}
+	if !from.Before(to) {
+		http.Error(w, "from must be before to", http.StatusBadRequest)
+		return
+	}
+	if to.Sub(from) > maxExportWindow {
+		http.Error(w, fmt.Sprintf("interval must not exceed %s, export longer ranges in parts", maxExportWindow), http.StatusBadRequest)
+		return
+	}
+
+	q := database.ObservationQuery{Region: region, Point: params.Get("point"), From: from, To: to}
+	name := fmt.Sprintf("%s-observations-%s", region, from.UTC().Format("20060102T1504Z"))
+	title := fmt.Sprintf("%s observations %s – %s", region, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+	s.serveExport(w, r, export.Observations, name, title, func(ctx context.Context, out export.Writer) error {
+		return export.ObservationRange(ctx, s.DB, q, out)
+	})
+}
+
+// Общая часть выгрузок: формат из параметра format, запись по мере чтения из базы.
+// Пока в ответ ничего не ушло, ошибка возвращается статусом HTTP. После этого статус 200 уже отправлен,
+// поэтому ответ обрывается без завершающего chunk: клиент видит оборванную передачу, а не короткий файл
+func (s *StormServer) serveExport(w http.ResponseWriter, r *http.Request, kind export.Kind, name, title string, produce func(context.Context, export.Writer) error) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = export.FormatGeoJSON
+	}
+	sent := &countingWriter{w: w}
+	buffered := bufio.NewWriter(sent)
+	out, err := export.New(format, kind, buffered, title)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Большая выгрузка пишется дольше WriteTimeout REST-сервера
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		log.Warn().Err(err).Msg("Failed to lift write deadline for export")
+	}
+	w.Header().Set("Content-Type", export.ContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+"."+export.Extension(format)))
+
+	err = produce(r.Context(), out)
+	if err == nil {
+		err = out.Close()
+	}
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if err == nil {
+		return
+	}
+
+	if sent.n > 0 {
+		if r.Context().Err() == nil {
+			log.Error().Err(err).Str("export", name).Int64("bytes", sent.n).Msg("Export interrupted, aborting response")
+		}
+		panic(http.ErrAbortHandler) // net/http закрывает соединение без записи в журнал
+	}
+	w.Header().Del("Content-Disposition")
+	if errors.Is(err, database.ErrNotFound) {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	log.Error().Err(err).Str("export", name).Msg("Failed to export")
+	http.Error(w, "failed to export", http.StatusInternalServerError)
+}
+
+// Сколько байт уже ушло клиенту
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,120 @@
+package rabbit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"Storm-Hunt/storm-backend/catalog"
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/export"
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Сервер выгрузки, produce которого пишет rows замеров и возвращает fail
+func exportServer(t *testing.T, rows int, fail error) *httptest.Server {
+	t.Helper()
+	s := &StormServer{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.serveExport(w, r, export.Observations, "test", "Test", func(ctx context.Context, out export.Writer) error {
+			at := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+			for i := 0; i < rows; i++ {
+				o := models.Observation{Region: "Atlantic", Point: "Miami", Lat: 25.76, Lon: -80.19, WindKmH: 40, Provider: "openmeteo", ObservedAt: at.Add(time.Duration(i) * time.Minute)}
+				if err := out.Observation(o); err != nil {
+					return err
+				}
+			}
+			return fail
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestServeExport(t *testing.T) {
+	tests := []struct {
+		name   string
+		rows   int
+		fail   error
+		status int
+		broken bool // Тело обрывается после статуса 200
+	}{
+		{"complete", 1000, nil, http.StatusOK, false},
+		{"not found before the first byte", 0, database.ErrNotFound, http.StatusNotFound, false},
+		{"error before the first byte", 10, errors.New("connection reset"), http.StatusInternalServerError, false},
+		// Строк больше буфера: часть файла уже ушла клиенту
+		{"error after the first byte", 1000, errors.New("connection reset"), http.StatusOK, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := exportServer(t, tt.rows, tt.fail)
+			resp, err := http.Get(server.URL + "?format=csv")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if resp.StatusCode != tt.status {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.status)
+			}
+			if tt.broken {
+				if err == nil {
+					t.Fatalf("read %d bytes of an interrupted export without an error", len(body))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			if tt.status == http.StatusOK {
+				if lines := strings.Count(string(body), "\n"); lines != tt.rows+1 {
+					t.Errorf("got %d CSV lines, want %d", lines, tt.rows+1)
+				}
+				if !strings.Contains(resp.Header.Get("Content-Disposition"), `filename="test.csv"`) {
+					t.Errorf("Content-Disposition = %q", resp.Header.Get("Content-Disposition"))
+				}
+			} else if resp.Header.Get("Content-Disposition") != "" {
+				t.Error("error response is offered as a file")
+			}
+		})
+	}
+}
+
+func TestServeObservationsExportParams(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "regions.yaml")
+	yaml := "regions:\n  - id: Atlantic\n    points:\n      - {name: Miami, lat: 25.76, lon: -80.19}\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	regions, err := catalog.Load(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &StormServer{Regions: regions}
+
+	tests := []struct {
+		query  string
+		status int
+	}{
+		{"", http.StatusBadRequest},
+		{"region=Pacific", http.StatusNotFound},
+		{"region=Atlantic&to=yesterday", http.StatusBadRequest},
+		{"region=Atlantic&from=2026-09-02T00:00:00Z&to=2026-09-01T00:00:00Z", http.StatusBadRequest},
+		{"region=Atlantic&from=2026-09-01T00:00:00Z&to=2026-09-08T00:00:01Z", http.StatusBadRequest}, // Длиннее maxExportWindow
+		{"region=Atlantic&from=2020-01-01T00:00:00Z", http.StatusBadRequest},                        // До текущего момента
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		s.ServeObservationsExport(w, httptest.NewRequest(http.MethodGet, "/v1/export/observations?"+tt.query, nil))
+		if w.Code != tt.status {
+			t.Errorf("%q: status = %d, want %d (%s)", tt.query, w.Code, tt.status, strings.TrimSpace(w.Body.String()))
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package rabbit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"Storm-Hunt/storm-backend/hub"
+	"Storm-Hunt/storm-backend/proto"
+	"Storm-Hunt/storm-backend/subscription"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	updateStreamKey = "storm_stream:%s" // Redis Stream обновлений региона от weather-worker; ID записи — <sequence>-0
+	maxReplay       = 1000              // Сколько пропущенных обновлений досылается за раз
+)
+
+// Подписка одного клиента на регион, общая для StartStream и HTTP-потоков:
+// лента хаба, досылка пропущенного и регистрация подписчика, запускающая опрос
+type regionFeed struct {
+	region  string
+	updates *hub.Subscriber
+	sub     *subscription.Subscription
+	backlog []*proto.WeatherData // Отправляется до живых обновлений: пропущенное после resume_from или последний замер из кэша
+
+	lastSeq  uint64 // Последнее отправленное обновление
+	lastTime string // То же для обновлений без номера
+}
+
+// Открытие подписки; resumeFrom — sequence последнего обновления, которое клиент уже получил
+func (s *StormServer) openFeed(ctx context.Context, region, userID string, resumeFrom uint64) (*regionFeed, error) {
+	// Подпишемся на канал прежде чем читать историю и регистрировать подписчика — чтобы не пропустить сообщение.
+	// Хаб держит одну подписку Redis на регион для всех потоков процесса
+	updates, err := s.Hub.Subscribe(ctx, region)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to subscribe to region updates: %v", err)
+	}
+	feed := &regionFeed{region: region, updates: updates}
+
+	if resumeFrom > 0 {
+		feed.backlog, err = s.replay(ctx, region, resumeFrom)
+		if err != nil {
+			log.Warn().Err(err).Str("region", region).Uint64("resume_from", resumeFrom).Msg("Failed to replay missed updates, sending the latest one")
+		} else if feed.backlog != nil {
+			feed.lastSeq = resumeFrom
+			if len(feed.backlog) > 0 {
+				log.Info().Str("region", region).Uint64("resume_from", resumeFrom).Int("updates", len(feed.backlog)).Msg("Replaying missed updates")
+			}
+		}
+	}
+
+	// Без resume_from (или если историю прочитать не удалось) сразу проверим кеш — возможно данные уже там
+	if feed.backlog == nil {
+		if val, err := s.Redis.Get(ctx, fmt.Sprintf("storm:%s", region)).Result(); err == nil {
+			if data, err := s.decodeWeather(region, []byte(val)); err == nil {
+				feed.backlog = []*proto.WeatherData{data}
+			}
+		}
+	}
+
+	// Первый подписчик запускает опрос в воркере, уход последнего останавливает его после grace-периода
+	feed.sub, err = s.Subscriptions.Acquire(ctx, region, userID)
+	if err != nil {
+		feed.close()
+		return nil, status.Errorf(codes.Unavailable, "failed to subscribe to region: %v", err)
+	}
+	return feed, nil
+}
+
+// Обновления региона после resumeFrom из Redis Stream. Пустой (не nil) срез — клиент ничего не пропустил;
+// nil — resumeFrom из другой истории (счётчик обнулился), и клиенту нужен последний замер
+func (s *StormServer) replay(ctx context.Context, region string, resumeFrom uint64) ([]*proto.WeatherData, error) {
+	key := fmt.Sprintf(updateStreamKey, region)
+	entries, err := s.Redis.XRangeN(ctx, key, fmt.Sprintf("(%d-0", resumeFrom), "+", maxReplay).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read update stream: %w", err)
+	}
+	if len(entries) == 0 {
+		latest, err := s.Redis.XRevRangeN(ctx, key, "+", "-", 1).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read update stream: %w", err)
+		}
+		if len(latest) == 0 || entrySequence(latest[0]) < resumeFrom {
+			return nil, nil
+		}
+		return []*proto.WeatherData{}, nil
+	}
+	if first := entrySequence(entries[0]); first > resumeFrom+1 {
+		log.Warn().Str("region", region).Uint64("resume_from", resumeFrom).Uint64("oldest", first).Msg("Missed updates were already trimmed from the stream")
+	}
+
+	backlog := make([]*proto.WeatherData, 0, len(entries))
+	for _, entry := range entries {
+		payload, _ := entry.Values["data"].(string)
+		data, err := s.decodeWeather(region, []byte(payload))
+		if err != nil {
+			log.Error().Err(err).Str("region", region).Str("id", entry.ID).Msg("Failed to decode stream entry")
+			continue
+		}
+		backlog = append(backlog, data)
+	}
+	return backlog, nil
+}
+
+// Номер обновления из ID записи <sequence>-0
+func entrySequence(entry redis.XMessage) uint64 {
+	ms, _, _ := strings.Cut(entry.ID, "-")
+	seq, _ := strconv.ParseUint(ms, 10, 64)
+	return seq
+}
+
+// Проверка, что обновление ещё не отправлялось клиенту; отмечает его отправленным
+func (f *regionFeed) fresh(data *proto.WeatherData) bool {
+	if data.Sequence > 0 {
+		if data.Sequence <= f.lastSeq {
+			return false
+		}
+		f.lastSeq = data.Sequence
+	} else if data.Timestamp == f.lastTime { // Обновление без номера от старого воркера
+		return false
+	}
+	f.lastTime = data.Timestamp
+	return true
+}
+
+func (f *regionFeed) close() {
+	if f.sub != nil {
+		f.sub.Release()
+	}
+	if dropped := f.updates.Dropped(); dropped > 0 {
+		log.Warn().Str("region", f.region).Int64("dropped", dropped).Msg("Slow stream consumer, dropped updates")
+	}
+	f.updates.Close()
+}
+
+// Пересылка досланных и живых замеров региона в общий канал клиента, пока не отменён ctx
+func (s *StormServer) relay(ctx context.Context, feed *regionFeed, out chan<- *proto.WeatherData) {
+	send := func(data *proto.WeatherData) bool {
+		if !feed.fresh(data) {
+			return true
+		}
+		select {
+		case out <- data:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for _, data := range feed.backlog {
+		if !send(data) {
+			return
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case payload, ok := <-feed.updates.Updates():
+			if !ok {
+				log.Error().Str("region", feed.region).Msg("Redis subscription channel closed")
+				return
+			}
+			data, err := s.decodeWeather(feed.region, []byte(payload))
+			if err != nil {
+				log.Error().Err(err).Str("region", feed.region).Msg("Failed to decode weather update")
+				continue
+			}
+			if !send(data) {
+				return
+			}
+		}
+	}
+}
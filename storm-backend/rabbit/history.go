@@ -0,0 +1,160 @@
+package rabbit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/proto"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultHistoryWindow = 48 * time.Hour // Окно выборки, если from не указан
+	defaultPageSize      = 500
+	maxPageSize          = 5000
+)
+
+// Длительность интервала агрегации; 0 — без агрегации
+var bucketSizes = map[proto.Bucket]time.Duration{
+	proto.Bucket_BUCKET_RAW: 0,
+	proto.Bucket_BUCKET_1M:  time.Minute,
+	proto.Bucket_BUCKET_10M: 10 * time.Minute,
+	proto.Bucket_BUCKET_1H:  time.Hour,
+}
+
+// GetObservations возвращает временной ряд замеров региона с агрегацией по интервалам
+func (s *StormServer) GetObservations(ctx context.Context, req *proto.GetObservationsRequest) (*proto.GetObservationsResponse, error) {
+	if _, ok := s.Regions.Get(req.Region); !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown region %q", req.Region)
+	}
+	bucket, ok := bucketSizes[req.Bucket]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported bucket %v", req.Bucket)
+	}
+
+	to := time.Now().UTC()
+	if req.To != "" {
+		parsed, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+		}
+		to = parsed
+	}
+	from := to.Add(-defaultHistoryWindow)
+	if req.From != "" {
+		parsed, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+		}
+		from = parsed
+	}
+	if bucket > 0 {
+		from = from.Truncate(bucket) // Первый интервал выравнивается по своей границе
+	}
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	query := database.ObservationQuery{
+		Region: req.Region,
+		Point:  req.Point,
+		From:   from,
+		To:     to,
+		Bucket: bucket,
+		Limit:  pageSize + 1, // Лишняя запись показывает, что есть следующая страница
+	}
+	if req.PageToken != "" {
+		cursor, afterID, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		if cursor.Before(from) || !cursor.Before(to) {
+			return nil, status.Error(codes.InvalidArgument, "page_token does not match the requested range")
+		}
+		query.From, query.AfterID = cursor, afterID
+	}
+
+	buckets, err := s.DB.QueryObservations(ctx, query)
+	if err != nil {
+		log.Error().Err(err).Str("region", req.Region).Msg("Failed to query observations")
+		return nil, status.Error(codes.Internal, "failed to query observations")
+	}
+
+	resp := &proto.GetObservationsResponse{Region: req.Region, Bucket: req.Bucket}
+	if len(buckets) > pageSize {
+		buckets = buckets[:pageSize]
+		last := buckets[len(buckets)-1]
+		if bucket > 0 {
+			resp.NextPageToken = encodePageToken(last.Start.Add(bucket), 0)
+		} else {
+			resp.NextPageToken = encodePageToken(last.Start, last.ID)
+		}
+	}
+	for _, b := range buckets {
+		resp.Buckets = append(resp.Buckets, bucketToProto(b))
+	}
+	return resp, nil
+}
+
+func bucketToProto(b models.ObservationBucket) *proto.ObservationBucket {
+	item := &proto.ObservationBucket{
+		Start:    b.Start.Format(time.RFC3339Nano),
+		Samples:  int32(b.Samples),
+		Point:    b.Point,
+		Provider: b.Provider,
+		Temp:     statsToProto(b.Temp),
+		Humidity: statsToProto(b.Humidity),
+		WindKmh:  statsToProto(b.WindKmH),
+	}
+	if b.Pressure != nil {
+		item.Pressure = statsToProto(*b.Pressure)
+	}
+	return item
+}
+
+func statsToProto(m models.MetricStats) *proto.MetricStats {
+	return &proto.MetricStats{Min: m.Min, Max: m.Max, Avg: m.Avg}
+}
+
+// Токен страницы: время, с которого продолжить выборку (unix ms), и id последнего отданного замера
+func encodePageToken(cursor time.Time, afterID int64) string {
+	raw := fmt.Sprintf("%d:%d", cursor.UnixMilli(), afterID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (time.Time, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	ms, id, found := strings.Cut(string(raw), ":")
+	if !found {
+		return time.Time{}, 0, fmt.Errorf("malformed token")
+	}
+	cursor, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	afterID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	return time.UnixMilli(cursor).UTC(), afterID, nil
+}
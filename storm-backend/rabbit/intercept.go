@@ -0,0 +1,101 @@
+package rabbit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/intercept"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/proto"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultChaserSpeed = 80  // км/ч, средняя скорость по дорогам
+	maxChaserSpeed     = 300 // Выше — уже не машина
+)
+
+// PlanIntercept оценивает движение открытого шторма по последним точкам трека
+// и считает, где и когда охотник из заданной точки его догонит
+func (s *StormServer) PlanIntercept(ctx context.Context, req *proto.PlanInterceptRequest) (*proto.PlanInterceptResponse, error) {
+	if req.StormId == "" {
+		return nil, status.Error(codes.InvalidArgument, "storm_id is required")
+	}
+	if req.Position == nil {
+		return nil, status.Error(codes.InvalidArgument, "position is required")
+	}
+	chaser := geo.Point{Lat: req.Position.Lat, Lon: req.Position.Lon}
+	if math.IsNaN(chaser.Lat) || math.IsNaN(chaser.Lon) || math.Abs(chaser.Lat) > 90 || math.Abs(chaser.Lon) > 180 {
+		return nil, status.Error(codes.InvalidArgument, "position must have lat in [-90, 90] and lon in [-180, 180]")
+	}
+	speed := req.SpeedKmh
+	if speed == 0 {
+		speed = defaultChaserSpeed
+	}
+	if !(speed > 0 && speed <= maxChaserSpeed) {
+		return nil, status.Errorf(codes.InvalidArgument, "speed_kmh must be in (0, %d]", maxChaserSpeed)
+	}
+	points := int(req.TrackPoints)
+	if points == 0 {
+		points = intercept.DefaultTrackPoints
+	}
+	if points < 2 || points > intercept.MaxTrackPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "track_points must be 2-%d", intercept.MaxTrackPoints)
+	}
+
+	storm, err := s.DB.GetStorm(ctx, req.StormId)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "unknown storm %q", req.StormId)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("storm", req.StormId).Msg("Failed to read storm")
+		return nil, status.Error(codes.Internal, "failed to read storm")
+	}
+	if storm.Status != models.StormOpen {
+		return nil, status.Errorf(codes.FailedPrecondition, "storm %q is closed", req.StormId)
+	}
+	track, err := s.DB.GetTrack(ctx, req.StormId)
+	if err != nil {
+		log.Error().Err(err).Str("storm", req.StormId).Msg("Failed to read storm track")
+		return nil, status.Error(codes.Internal, "failed to read storm track")
+	}
+
+	// Без точек трека остаётся последнее положение из карточки шторма
+	position := geo.Point{Lat: float64(storm.Lat), Lon: float64(storm.Lon)}
+	observedAt := storm.UpdatedAt
+	if len(track) > 0 {
+		last := track[len(track)-1]
+		position = geo.Point{Lat: float64(last.Lat), Lon: float64(last.Lon)}
+		observedAt = last.ObservedAt
+	}
+	motion := intercept.EstimateMotion(track, points)
+	plan := intercept.Compute(chaser, speed, position, observedAt, motion, time.Now())
+
+	resp := &proto.PlanInterceptResponse{
+		StormId:       storm.ID,
+		StormPosition: &proto.LatLon{Lat: position.Lat, Lon: position.Lon},
+		ObservedAt:    observedAt.UTC().Format(time.RFC3339),
+		DistanceKm:    plan.DistanceKm,
+		BearingDeg:    plan.Bearing,
+		Motion: &proto.StormMotion{
+			SpeedKmh:   motion.SpeedKmH,
+			HeadingDeg: motion.Heading,
+			Points:     int32(motion.Points),
+		},
+		Reachable: plan.Reachable,
+	}
+	if plan.Reachable {
+		resp.Intercept = &proto.LatLon{Lat: plan.Intercept.Lat, Lon: plan.Intercept.Lon}
+		resp.InterceptAt = plan.InterceptAt.Format(time.RFC3339)
+		resp.TravelKm = plan.TravelKm
+		resp.HeadingDeg = plan.HeadingTo
+	}
+	return resp, nil
+}
@@ -0,0 +1,141 @@
+package rabbit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/models"
+
+	"github.com/rs/zerolog/log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const ObservationsQueue = "weather_observations" // Очередь замеров, которые weather-worker отправляет после каждого опроса
+
+// Получатель циклов опроса после их записи (например, детектор штормов)
+type CycleObserver interface {
+	Observe(ctx context.Context, cycle models.ObservationCycle) error
+}
+
+// Пакетная запись замеров из очереди в хранилище
+type ObservationWriter struct {
+	conn      *amqp.Connection
+	store     database.Store
+	batchSize int           // Максимальный размер пакета вставки (в замерах)
+	flush     time.Duration // Максимальное время ожидания неполного пакета
+
+	Detector CycleObserver // Необязательный получатель записанных циклов
+}
+
+func NewObservationWriter(conn *amqp.Connection, store database.Store, batchSize int, flush time.Duration) *ObservationWriter {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &ObservationWriter{conn: conn, store: store, batchSize: batchSize, flush: flush}
+}
+
+// Чтение очереди до отмены контекста. Сообщения подтверждаются только после успешной вставки пакета,
+// поэтому при сбое БД они вернутся в очередь
+func (w *ObservationWriter) Run(ctx context.Context) error {
+	ch, err := w.conn.Channel() // Отдельный канал: подтверждения не должны мешать публикации задач
+	if err != nil {
+		return fmt.Errorf("failed to open observations channel: %w", err)
+	}
+	defer ch.Close()
+
+	if _, err := ch.QueueDeclare(ObservationsQueue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("failed to declare observations queue: %w", err)
+	}
+	if err := ch.Qos(w.batchSize*2, 0, false); err != nil { // Пока пишется один пакет, копится следующий
+		return fmt.Errorf("failed to set QoS: %w", err)
+	}
+	deliveries, err := ch.Consume(ObservationsQueue, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("failed to register observations consumer: %w", err)
+	}
+
+	batch := make([]models.Observation, 0, w.batchSize)
+	var cycles []models.ObservationCycle
+	var lastTag uint64 // Тег последнего сообщения пакета для группового подтверждения
+	ticker := time.NewTicker(w.flush)
+	defer ticker.Stop()
+
+	flush := func() {
+		if lastTag == 0 {
+			return
+		}
+		writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := w.store.InsertObservations(writeCtx, batch)
+		cancel()
+		if err != nil {
+			log.Error().Err(err).Int("observations", len(batch)).Msg("Failed to write observations, requeueing")
+			_ = ch.Nack(lastTag, true, true)
+		} else {
+			log.Debug().Int("observations", len(batch)).Msg("Observations written")
+			_ = ch.Ack(lastTag, true)
+			w.observe(cycles)
+		}
+		batch = batch[:0]
+		cycles = cycles[:0]
+		lastTag = 0
+	}
+
+	log.Info().Int("batch", w.batchSize).Dur("flush", w.flush).Msg("Observation writer started")
+	for {
+		select {
+		case <-ctx.Done():
+			flush()
+			return nil
+		case <-ticker.C:
+			flush()
+		case d, ok := <-deliveries:
+			if !ok {
+				flush()
+				return fmt.Errorf("observations channel closed unexpectedly")
+			}
+			var cycle models.ObservationCycle
+			if err := json.Unmarshal(d.Body, &cycle); err != nil || !validCycle(cycle) {
+				log.Error().Err(err).Msg("Dropping malformed observations")
+				_ = d.Reject(false)
+				continue
+			}
+			batch = append(batch, cycle.Observations...)
+			cycles = append(cycles, cycle)
+			lastTag = d.DeliveryTag
+			if len(batch) >= w.batchSize {
+				flush()
+			}
+		}
+	}
+}
+
+// Передача записанных циклов детектору. Ошибки не возвращают сообщения в очередь:
+// замеры уже сохранены, а цикл, обработанный наполовину, нельзя безопасно повторить
+func (w *ObservationWriter) observe(cycles []models.ObservationCycle) {
+	if w.Detector == nil {
+		return
+	}
+	for _, cycle := range cycles {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		if err := w.Detector.Observe(ctx, cycle); err != nil {
+			log.Error().Err(err).Str("region", cycle.Region).Msg("Storm detector failed to process cycle")
+		}
+		cancel()
+	}
+}
+
+func validCycle(cycle models.ObservationCycle) bool {
+	if cycle.Region == "" || cycle.FetchedAt.IsZero() || len(cycle.Observations) == 0 {
+		return false
+	}
+	for _, obs := range cycle.Observations {
+		if obs.Region != cycle.Region || obs.ObservedAt.IsZero() {
+			return false
+		}
+	}
+	return true
+}
@@ -1,113 +1,228 @@
 package rabbit
 
 import (
+	"Storm-Hunt/storm-backend/catalog"
+	"Storm-Hunt/storm-backend/classify"
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/hub"
+	"Storm-Hunt/storm-backend/keycloak"
 	"Storm-Hunt/storm-backend/models"
 	"Storm-Hunt/storm-backend/proto"
-	"database/sql"
+	"Storm-Hunt/storm-backend/subscription"
+	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 type StormServer struct {
 	proto.UnimplementedStormServiceServer
-	DB       *sql.DB
+	DB       database.Store
 	Redis    *redis.Client
 	AMQPConn *amqp.Connection
 	AMQPChan *amqp.Channel
+
+	Regions       *catalog.Catalog       // Каталог регионов; неизвестные регионы отклоняются
+	Subscriptions *subscription.Registry // Общий для реплик учёт подписчиков на регионы
+	Hub           *hub.Hub               // Раздача обновлений Redis по потокам процесса
+	StormHub      *hub.Hub               // Раздача событий штормов по WebSocket-подпискам
+	AlertHub      *hub.Hub               // Раздача оповещений по WebSocket-подпискам; ключ вместо региона — subject пользователя
+	ZoneHub       *hub.Hub               // Раздача событий зон наблюдения, ключ — subject пользователя
+	Heartbeat     time.Duration          // Период повтора последнего кадра в потоке, 0 — выключено
+	KeepAlive     time.Duration          // Период комментариев keep-alive в SSE-потоках
+	Thresholds    *classify.Thresholds   // Пороги классификации ветра в кадрах потока
+
+	AllowPrivateWebhooks bool // Принимать адреса уведомлений во внутренней сети, как WEBHOOK_ALLOW_PRIVATE
+
+	mu      sync.Mutex                            // Защита реестра активных потоков
+	streams map[string]map[*activeStream]struct{} // Активные потоки по регионам
 }
 
 // StartStream отправляет задачу в RabbitMQ
 func (s *StormServer) StartStream(req *proto.StartStreamRequest, stream proto.StormService_StartStreamServer) error {
-	ctx := stream.Context()
-	cacheKey := fmt.Sprintf("storm:%s", req.Region)
-	channel := fmt.Sprintf("storm_updates:%s", req.Region)
+	claims, ok := keycloak.ClaimsFromContext(stream.Context()) // Пользователь берётся из проверенного токена, а не из запроса
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	userID := claims.Subject
+	if _, ok := s.Regions.Get(req.Region); !ok {
+		return status.Errorf(codes.NotFound, "unknown region %q", req.Region)
+	}
+
+	// Собственный контекст потока, чтобы StopStream мог закрыть его со стороны сервера
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	defer s.trackStream(req.Region, &activeStream{userID: userID, cancel: cancel})()
 
-	log.Info().Str("region", req.Region).Str("user", req.UserId).Msg("StartStream called")
+	log.Info().Str("region", req.Region).Str("user", userID).Msg("StartStream called")
 
-	// Подпишемся на канал прежде чем публиковать задачу — чтобы не пропустить сообщение
-	pubsub := s.Redis.Subscribe(ctx, channel)
-	// Если Subscribe вернул ошибку, отдадим её
-	if pubsub == nil {
-		return fmt.Errorf("failed to subscribe to redis channel %s", channel)
+	feed, err := s.openFeed(ctx, req.Region, userID, req.ResumeFrom)
+	if err != nil {
+		return err
 	}
-	defer func() {
-		_ = pubsub.Close()
-	}()
-
-	// Сразу проверим кеш — возможно данные уже там
-	if val, err := s.Redis.Get(ctx, cacheKey).Result(); err == nil {
-		var data models.CacheData
-		if err := json.Unmarshal([]byte(val), &data); err == nil {
-			log.Info().Str("region", req.Region).Msg("Sending cached value immediately")
-			if err := stream.Send(&proto.WeatherData{
-				Region:    req.Region,
-				Lat:       data.Lat,
-				Lon:       data.Lon,
-				Temp:      data.Temp,
-				Humidity:  float32(data.Humidity),
-				WindKmh:   int32(data.WindKmH),
-				Timestamp: data.Timestamp,
-			}); err != nil {
-				return err
-			}
-			// Не возвращаемся — продолжаем слушать последующие обновления
+	defer feed.close()
+
+	var last *proto.WeatherData // Последний отправленный кадр — для heartbeat
+	send := func(data *proto.WeatherData) error {
+		if !feed.fresh(data) { // Этот замер клиент уже получил
+			return nil
+		}
+		if err := stream.Send(data); err != nil {
+			return err
 		}
+		last = data
+		return nil
 	}
 
-	// Публикуем задачу в RabbitMQ для воркера (после подписки)
-	task := struct {
-		Region string `json:"region"`
-		UserID string `json:"user_id"`
-	}{Region: req.Region, UserID: req.UserId}
-
-	body, _ := json.Marshal(task)
-	if err := s.AMQPChan.Publish("", "weather_tasks", false, false, amqp.Publishing{
-		ContentType: "application/json",
-		Body:        body,
-	}); err != nil {
-		return err
+	// Пропущенные после resume_from обновления или последний замер из кэша
+	for _, data := range feed.backlog {
+		if err := send(data); err != nil {
+			return err
+		}
 	}
-	log.Info().Str("region", req.Region).Msg("Published weather task to RabbitMQ")
 
-	// Получаем канал сообщений pubsub
-	ch := pubsub.Channel()
+	// Необязательный heartbeat: повтор последнего кадра, чтобы прокси не закрывали тихий поток
+	var heartbeat <-chan time.Time
+	if s.Heartbeat > 0 {
+		ticker := time.NewTicker(s.Heartbeat)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
 
 	for {
 		select {
 		case <-ctx.Done():
 			log.Info().Str("region", req.Region).Msg("StartStream context done")
+			if stream.Context().Err() == nil { // Поток закрыт через StopStream — это штатное завершение
+				return nil
+			}
 			return ctx.Err()
-		case _, ok := <-ch:
+		case payload, ok := <-feed.updates.Updates():
 			if !ok {
 				log.Error().Str("region", req.Region).Msg("Redis subscription channel closed")
-				return fmt.Errorf("subscription channel closed")
+				return status.Error(codes.Unavailable, "subscription channel closed")
 			}
-			// ...
-		case <-time.After(5 * time.Second):
-			// можно просто проверять кеш периодически, не закрываясь
-			if val, err := s.Redis.Get(ctx, cacheKey).Result(); err == nil {
-				var data models.CacheData
-				if err := json.Unmarshal([]byte(val), &data); err == nil {
-					log.Info().Str("region", req.Region).Msg("Sending cached value periodically")
-					if err := stream.Send(&proto.WeatherData{
-						Region:    req.Region,
-						Lat:       data.Lat,
-						Lon:       data.Lon,
-						Temp:      data.Temp,
-						Humidity:  float32(data.Humidity),
-						WindKmh:   int32(data.WindKmH),
-						Timestamp: data.Timestamp,
-					}); err != nil {
-						return err
-					}
-				}
+			data, err := s.decodeWeather(req.Region, []byte(payload))
+			if err != nil {
+				log.Error().Err(err).Str("region", req.Region).Msg("Failed to decode weather update")
+				continue
+			}
+			if err := send(data); err != nil {
+				return err
+			}
+		case <-heartbeat:
+			if last == nil {
+				continue
+			}
+			if err := stream.Send(&proto.WeatherData{
+				Region:         last.Region,
+				Temp:           last.Temp,
+				Humidity:       last.Humidity,
+				Lat:            last.Lat,
+				Lon:            last.Lon,
+				WindKmh:        last.WindKmh,
+				Timestamp:      last.Timestamp,
+				Point:          last.Point,
+				Beaufort:       last.Beaufort,
+				Category:       last.Category,
+				Severity:       last.Severity,
+				Classification: last.Classification,
+				Sequence:       last.Sequence,
+				Heartbeat:      true,
+			}); err != nil {
+				return err
 			}
 		}
 	}
 }
+
+// Декодирование замера из кэша или из сообщения pub/sub
+func (s *StormServer) decodeWeather(region string, payload []byte) (*proto.WeatherData, error) {
+	var data models.CacheData
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode weather payload: %w", err)
+	}
+	class := s.Thresholds.Classify(float32(data.WindKmH), data.Lat) // Балл и категория, чтобы клиентам не считать их самим
+	return &proto.WeatherData{
+		Region:         region,
+		Lat:            data.Lat,
+		Lon:            data.Lon,
+		Temp:           data.Temp,
+		Humidity:       float32(data.Humidity),
+		WindKmh:        int32(data.WindKmH),
+		Timestamp:      data.Timestamp,
+		Point:          data.Point,
+		Beaufort:       int32(class.Beaufort),
+		Category:       int32(class.Category),
+		Severity:       proto.Severity(class.Severity),
+		Classification: class.Description,
+		Sequence:       data.Sequence,
+	}, nil
+}
+
+// StopStream закрывает потоки пользователя по региону; опрос остановится, когда у региона не останется подписчиков
+func (s *StormServer) StopStream(ctx context.Context, req *proto.StopStreamRequest) (*proto.StopStreamResponse, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	if _, ok := s.Regions.Get(req.Region); !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown region %q", req.Region)
+	}
+
+	stopped, remaining := s.cancelUserStreams(req.Region, claims.Subject)
+	log.Info().Str("region", req.Region).Str("user", claims.Subject).Int("streams", stopped).Msg("StopStream called")
+
+	// Закрытые потоки сами снимут свои подписки при выходе
+	return &proto.StopStreamResponse{
+		Region:         req.Region,
+		StoppedStreams: int32(stopped),
+		NoLocalStreams: remaining == 0,
+	}, nil
+}
+
+// ListRegions возвращает включённые регионы каталога, чтобы клиенты не хранили их у себя
+func (s *StormServer) ListRegions(ctx context.Context, req *proto.ListRegionsRequest) (*proto.ListRegionsResponse, error) {
+	resp := &proto.ListRegionsResponse{}
+	for _, region := range s.Regions.Regions() {
+		item := &proto.Region{
+			Id:              region.ID,
+			Name:            region.Name,
+			Provider:        region.Provider,
+			IntervalSeconds: int32(region.Interval.Seconds()),
+		}
+		for _, point := range region.Points {
+			item.Points = append(item.Points, &proto.SamplePoint{Name: point.Name, Lat: point.Lat, Lon: point.Lon})
+		}
+		resp.Regions = append(resp.Regions, item)
+	}
+	return resp, nil
+}
+
+// ListSubscriptions показывает число подписчиков по регионам (для отладки)
+func (s *StormServer) ListSubscriptions(ctx context.Context, req *proto.ListSubscriptionsRequest) (*proto.ListSubscriptionsResponse, error) {
+	stats, err := s.Subscriptions.Stats(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to read subscriptions: %v", err)
+	}
+
+	resp := &proto.ListSubscriptionsResponse{}
+	for _, st := range stats {
+		resp.Regions = append(resp.Regions, &proto.RegionSubscriptions{
+			Region:           st.Region,
+			Subscribers:      st.Active,
+			Lingering:        st.Lingering,
+			LocalSubscribers: int32(st.Local),
+			Generation:       st.Generation,
+		})
+	}
+	return resp, nil
+}
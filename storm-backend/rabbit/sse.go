@@ -0,0 +1,166 @@
+package rabbit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"Storm-Hunt/storm-backend/keycloak"
+	"Storm-Hunt/storm-backend/proto"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const sseRetry = 3 * time.Second // Через сколько EventSource переподключается после обрыва
+
+// Формат data событий совпадает с ответами REST-шлюза
+var sseMarshal = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// ServeUpdates отдаёт замеры регионов как text/event-stream:
+// GET /v1/storm/updates?region=Atlantic&region=Pacific.
+// Подписки те же, что у StartStream; проверку токена и ролей делает middleware.RequireHTTP.
+// id события хранит sequence последнего отправленного обновления каждого региона, поэтому
+// после переподключения с Last-Event-ID пропущенное досылается, а полученное не повторяется
+func (s *StormServer) ServeUpdates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	claims, ok := keycloak.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "missing verified claims", http.StatusUnauthorized)
+		return
+	}
+	userID := claims.Subject
+
+	var regions []string
+	seen := make(map[string]bool)
+	for _, region := range r.URL.Query()["region"] {
+		if seen[region] {
+			continue
+		}
+		if _, ok := s.Regions.Get(region); !ok {
+			http.Error(w, fmt.Sprintf("unknown region %q", region), http.StatusNotFound)
+			return
+		}
+		seen[region] = true
+		regions = append(regions, region)
+	}
+	if len(regions) == 0 {
+		http.Error(w, "at least one region parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	// Некорректный Last-Event-ID просто игнорируется: клиент получит последний замер ещё раз
+	delivered, _ := url.ParseQuery(r.Header.Get("Last-Event-ID"))
+	lastIDs := url.Values{}
+	resume := make(map[string]uint64)
+	for _, region := range regions {
+		if seq, err := strconv.ParseUint(delivered.Get(region), 10, 64); err == nil {
+			lastIDs.Set(region, delivered.Get(region))
+			resume[region] = seq
+		}
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait() // Подписки снимаются до выхода из обработчика
+	}()
+
+	// Подписки открываются до заголовков ответа, чтобы ошибку можно было вернуть статусом HTTP
+	feeds := make([]*regionFeed, 0, len(regions))
+	for _, region := range regions {
+		feed, err := s.openFeed(ctx, region, userID, resume[region])
+		if err != nil {
+			for _, opened := range feeds {
+				opened.close()
+			}
+			http.Error(w, status.Convert(err).Message(), http.StatusServiceUnavailable)
+			return
+		}
+		feeds = append(feeds, feed)
+	}
+	log.Info().Strs("regions", regions).Str("user", userID).Msg("SSE stream opened")
+
+	// Каждый регион закрывается отдельно: StopStream по региону останавливает только его
+	events := make(chan *proto.WeatherData)
+	for _, feed := range feeds {
+		regionCtx, regionCancel := context.WithCancel(ctx)
+		untrack := s.trackStream(feed.region, &activeStream{userID: userID, cancel: regionCancel})
+		wg.Add(1)
+		go func(feed *regionFeed) {
+			defer wg.Done()
+			defer untrack()
+			defer regionCancel()
+			defer feed.close()
+			s.relay(regionCtx, feed, events)
+		}(feed)
+	}
+	allStopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(allStopped)
+	}()
+
+	rc := http.NewResponseController(w)
+	// Поток живёт дольше WriteTimeout REST-сервера
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.Warn().Err(err).Msg("Failed to lift write deadline for SSE stream")
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Отключение буферизации в прокси
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", sseRetry.Milliseconds())
+	if err := rc.Flush(); err != nil {
+		log.Error().Err(err).Msg("SSE streaming is not supported by the response writer")
+		return
+	}
+
+	keepAlive := time.NewTicker(s.KeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Strs("regions", regions).Str("user", userID).Msg("SSE stream closed by client")
+			return
+		case <-allStopped:
+			log.Info().Strs("regions", regions).Str("user", userID).Msg("SSE stream stopped")
+			return
+		case data := <-events:
+			payload, err := sseMarshal.Marshal(data)
+			if err != nil {
+				log.Error().Err(err).Str("region", data.Region).Msg("Failed to encode SSE event")
+				continue
+			}
+			if data.Sequence > 0 {
+				lastIDs.Set(data.Region, strconv.FormatUint(data.Sequence, 10))
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: weather\ndata: %s\n\n", lastIDs.Encode(), payload); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			// Комментарий не виден клиенту, но не даёт прокси закрыть тихое соединение
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package rabbit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"Storm-Hunt/storm-backend/classify"
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/forecast"
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/intercept"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/proto"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	defaultStormsPageSize = 100
+	maxStormsPageSize     = 1000
+)
+
+var stormStatuses = map[proto.StormStatus]string{
+	proto.StormStatus_STORM_STATUS_ANY:    "",
+	proto.StormStatus_STORM_STATUS_ACTIVE: models.StormOpen,
+	proto.StormStatus_STORM_STATUS_CLOSED: models.StormClosed,
+}
+
+// ListStorms возвращает обнаруженные штормы по фильтру, сначала самые поздние
+func (s *StormServer) ListStorms(ctx context.Context, req *proto.ListStormsRequest) (*proto.ListStormsResponse, error) {
+	stormStatus, ok := stormStatuses[req.Status]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported status %v", req.Status)
+	}
+	if req.MinCategory < 0 || req.MinCategory > 5 {
+		return nil, status.Error(codes.InvalidArgument, "min_category must be 0-5")
+	}
+	if req.Source != "" && req.Source != models.StormSourceLive && req.Source != models.StormSourceHURDAT2 {
+		return nil, status.Errorf(codes.InvalidArgument, "source must be %s or %s", models.StormSourceLive, models.StormSourceHURDAT2)
+	}
+
+	filter := database.StormFilter{
+		Status:      stormStatus,
+		Region:      req.Region,
+		MinCategory: int(req.MinCategory),
+		Source:      req.Source,
+		Limit:       int(req.PageSize),
+	}
+	if req.From != "" {
+		parsed, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+		}
+		filter.From = parsed
+	}
+	if req.To != "" {
+		parsed, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+		}
+		filter.To = parsed
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultStormsPageSize
+	}
+	if filter.Limit > maxStormsPageSize {
+		filter.Limit = maxStormsPageSize
+	}
+
+	storms, err := s.DB.ListStorms(ctx, filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list storms")
+		return nil, status.Error(codes.Internal, "failed to list storms")
+	}
+
+	resp := &proto.ListStormsResponse{}
+	for i := range storms {
+		resp.Storms = append(resp.Storms, stormToProto(&storms[i]))
+	}
+	return resp, nil
+}
+
+// GetStorm возвращает карточку шторма; у открытого шторма — с прогнозом по последним точкам трека
+func (s *StormServer) GetStorm(ctx context.Context, req *proto.GetStormRequest) (*proto.Storm, error) {
+	if req.StormId == "" {
+		return nil, status.Error(codes.InvalidArgument, "storm_id is required")
+	}
+	storm, err := s.DB.GetStorm(ctx, req.StormId)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "unknown storm %q", req.StormId)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("storm", req.StormId).Msg("Failed to read storm")
+		return nil, status.Error(codes.Internal, "failed to read storm")
+	}
+	item := stormToProto(storm)
+	if storm.Status != models.StormOpen {
+		return item, nil
+	}
+	track, err := s.DB.GetTrack(ctx, req.StormId)
+	if err != nil {
+		log.Error().Err(err).Str("storm", req.StormId).Msg("Failed to read storm track")
+		return nil, status.Error(codes.Internal, "failed to read storm track")
+	}
+	setForecast(item, forecast.Project(track, intercept.DefaultTrackPoints))
+	return item, nil
+}
+
+// GetStormTrack возвращает путь шторма как GeoJSON Feature
+func (s *StormServer) GetStormTrack(ctx context.Context, req *proto.GetStormTrackRequest) (*proto.GetStormTrackResponse, error) {
+	if req.StormId == "" {
+		return nil, status.Error(codes.InvalidArgument, "storm_id is required")
+	}
+	storm, err := s.DB.GetStorm(ctx, req.StormId)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "unknown storm %q", req.StormId)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("storm", req.StormId).Msg("Failed to read storm")
+		return nil, status.Error(codes.Internal, "failed to read storm")
+	}
+	track, err := s.DB.GetTrack(ctx, req.StormId)
+	if err != nil {
+		log.Error().Err(err).Str("storm", req.StormId).Msg("Failed to read storm track")
+		return nil, status.Error(codes.Internal, "failed to read storm track")
+	}
+
+	feature, err := structpb.NewStruct(s.trackFeature(storm, track))
+	if err != nil {
+		log.Error().Err(err).Str("storm", req.StormId).Msg("Failed to encode storm track")
+		return nil, status.Error(codes.Internal, "failed to encode storm track")
+	}
+	return &proto.GetStormTrackResponse{Feature: feature}, nil
+}
+
+// GeoJSON Feature трека (RFC 7946). Свойства вершин лежат в coordinateProperties
+// параллельными массивами — так их читают QGIS и большинство библиотек карт
+func (s *StormServer) trackFeature(storm *models.Storm, track []models.TrackPoint) map[string]interface{} {
+	var (
+		coordinates = make([]interface{}, 0, len(track))
+		times       = make([]interface{}, 0, len(track))
+		points      = make([]interface{}, 0, len(track))
+		winds       = make([]interface{}, 0, len(track))
+		pressures   = make([]interface{}, 0, len(track))
+		beauforts   = make([]interface{}, 0, len(track))
+		categories  = make([]interface{}, 0, len(track))
+	)
+	for _, p := range track {
+		c := s.Thresholds.Classify(p.WindKmH, p.Lat)
+		coordinates = append(coordinates, []interface{}{round(p.Lon, 1e5), round(p.Lat, 1e5)})
+		times = append(times, p.ObservedAt.Format(time.RFC3339))
+		points = append(points, p.Point)
+		winds = append(winds, round(p.WindKmH, 10))
+		if p.Pressure != 0 {
+			pressures = append(pressures, round(p.Pressure, 10))
+		} else {
+			pressures = append(pressures, nil)
+		}
+		beauforts = append(beauforts, c.Beaufort)
+		categories = append(categories, c.Category)
+	}
+
+	// LineString требует минимум две вершины; у только что открытого шторма одна точка
+	var geometry interface{}
+	switch len(coordinates) {
+	case 0:
+	case 1:
+		geometry = map[string]interface{}{"type": "Point", "coordinates": coordinates[0]}
+	default:
+		geometry = map[string]interface{}{"type": "LineString", "coordinates": coordinates}
+	}
+
+	properties := map[string]interface{}{
+		"storm_id":      storm.ID,
+		"name":          nil,
+		"source":        storm.Source,
+		"region":        storm.Region,
+		"status":        storm.Status,
+		"started_at":    storm.StartedAt.Format(time.RFC3339),
+		"ended_at":      nil,
+		"peak_wind_kmh": round(storm.PeakWindKmH, 10),
+		"peak_at":       storm.PeakAt.Format(time.RFC3339),
+		"min_pressure":  nil,
+		"beaufort":      storm.Beaufort,
+		"category":      storm.Category,
+		"severity":      classify.Severity(storm.Severity).String(),
+		"coordinateProperties": map[string]interface{}{
+			"times":    times,
+			"point":    points,
+			"wind_kmh": winds,
+			"pressure": pressures,
+			"beaufort": beauforts,
+			"category": categories,
+		},
+	}
+	if storm.EndedAt != nil {
+		properties["ended_at"] = storm.EndedAt.Format(time.RFC3339)
+	}
+	if storm.Name != "" {
+		properties["name"] = storm.Name
+	}
+	if storm.Source == models.StormSourceHURDAT2 { // Поля best track, которых у обнаруженных штормов нет
+		var (
+			statuses = make([]interface{}, 0, len(track))
+			records  = make([]interface{}, 0, len(track))
+			radii    = make([]interface{}, 0, len(track))
+		)
+		for _, p := range track {
+			statuses = append(statuses, p.Status)
+			records = append(records, p.Record)
+			radii = append(radii, windRadiiProperty(p.WindRadii))
+		}
+		vertices := properties["coordinateProperties"].(map[string]interface{})
+		vertices["system_status"], vertices["record"], vertices["wind_radii"] = statuses, records, radii
+	}
+	if storm.MinPressure != 0 {
+		properties["min_pressure"] = round(storm.MinPressure, 10)
+	}
+
+	return map[string]interface{}{
+		"type":       "Feature",
+		"id":         storm.ID,
+		"geometry":   geometry,
+		"properties": properties,
+	}
+}
+
+func stormToProto(storm *models.Storm) *proto.Storm {
+	item := &proto.Storm{
+		Id:          storm.ID,
+		Region:      storm.Region,
+		Status:      proto.StormStatus_STORM_STATUS_ACTIVE,
+		StartedAt:   storm.StartedAt.Format(time.RFC3339),
+		UpdatedAt:   storm.UpdatedAt.Format(time.RFC3339),
+		Lat:         storm.Lat,
+		Lon:         storm.Lon,
+		WindKmh:     storm.WindKmH,
+		PeakWindKmh: storm.PeakWindKmH,
+		PeakAt:      storm.PeakAt.Format(time.RFC3339),
+		MinPressure: storm.MinPressure,
+		Beaufort:    int32(storm.Beaufort),
+		Category:    int32(storm.Category),
+		Severity:    proto.Severity(storm.Severity),
+		Name:        storm.Name,
+		Source:      storm.Source,
+	}
+	if storm.Status == models.StormClosed {
+		item.Status = proto.StormStatus_STORM_STATUS_CLOSED
+	}
+	if storm.EndedAt != nil {
+		item.EndedAt = storm.EndedAt.Format(time.RFC3339)
+	}
+	return item
+}
+
+// Прогноз в карточке шторма как GeoJSON FeatureCollection; ошибка кодирования только логируется
+func setForecast(item *proto.Storm, f *models.StormForecast) {
+	if f == nil {
+		return
+	}
+	encoded, err := structpb.NewStruct(forecastFeatures(f))
+	if err != nil {
+		log.Error().Err(err).Str("storm", item.Id).Msg("Failed to encode storm forecast")
+		return
+	}
+	item.Forecast = encoded
+}
+
+// Конус, линия прогноза от последней точки трека и ожидаемые положения; вид объекта — в properties.kind
+func forecastFeatures(f *models.StormForecast) map[string]interface{} {
+	lonLat := func(p geo.Point) []interface{} {
+		return []interface{}{math.Round(p.Lon*1e5) / 1e5, math.Round(p.Lat*1e5) / 1e5}
+	}
+
+	ring := make([]interface{}, 0, len(f.Cone)+1)
+	for _, p := range f.Cone {
+		ring = append(ring, lonLat(p))
+	}
+	if len(f.Cone) > 0 {
+		ring = append(ring, lonLat(f.Cone[0])) // Кольцо GeoJSON замкнуто
+	}
+	line := []interface{}{lonLat(f.Origin)}
+	features := []interface{}{
+		map[string]interface{}{
+			"type":     "Feature",
+			"geometry": map[string]interface{}{"type": "Polygon", "coordinates": []interface{}{ring}},
+			"properties": map[string]interface{}{
+				"kind":      "cone",
+				"issued_at": f.IssuedAt.Format(time.RFC3339),
+			},
+		},
+	}
+	for _, pos := range f.Positions {
+		// Как и у конуса, долгота отсчитывается от начала, чтобы у антимеридиана объекты не разрывались
+		point := lonLat(geo.Point{Lat: pos.Lat, Lon: f.Origin.Lon + geo.NormalizeLon(pos.Lon-f.Origin.Lon)})
+		line = append(line, point)
+		features = append(features, map[string]interface{}{
+			"type":     "Feature",
+			"geometry": map[string]interface{}{"type": "Point", "coordinates": point},
+			"properties": map[string]interface{}{
+				"kind":       "position",
+				"lead_hours": pos.LeadHours,
+				"valid_at":   pos.ValidAt.Format(time.RFC3339),
+				"radius_km":  pos.RadiusKm,
+			},
+		})
+	}
+	features = append(features, map[string]interface{}{
+		"type":     "Feature",
+		"geometry": map[string]interface{}{"type": "LineString", "coordinates": line},
+		"properties": map[string]interface{}{
+			"kind":      "track",
+			"speed_kmh": math.Round(f.SpeedKmH*10) / 10,
+			"heading":   math.Round(f.Heading*10) / 10,
+		},
+	})
+
+	return map[string]interface{}{
+		"type":     "FeatureCollection",
+		"features": features,
+	}
+}
+
+// Радиусы ветра вершины трека, км: {"kt34": [NE, SE, SW, NW], "kt50": ..., "kt64": ..., "max_wind_radius": ...}
+func windRadiiProperty(radii *models.WindRadii) interface{} {
+	if radii == nil {
+		return nil
+	}
+	quadrants := func(values [4]float32) []interface{} {
+		out := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			out = append(out, round(v, 10))
+		}
+		return out
+	}
+	property := map[string]interface{}{
+		"kt34":            quadrants(radii.Kt34),
+		"kt50":            quadrants(radii.Kt50),
+		"kt64":            quadrants(radii.Kt64),
+		"max_wind_radius": nil,
+	}
+	if radii.MaxWindRadius != 0 {
+		property["max_wind_radius"] = round(radii.MaxWindRadius, 10)
+	}
+	return property
+}
+
+// Округление float32 из базы, чтобы в GeoJSON не попадал шум двоичного представления
+// (scale 1e5 — координаты с точностью около метра, 10 — десятые км/ч и гПа)
+func round(v float32, scale float64) float64 {
+	return math.Round(float64(v)*scale) / scale
+}
@@ -0,0 +1,133 @@
+package rabbit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/keycloak"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/proto"
+	"Storm-Hunt/storm-backend/webhooks"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxWebhooks = 20 // Адресов у одного пользователя
+
+// CreateWebhook регистрирует адрес уведомлений текущего пользователя; секрет подписи возвращается только здесь
+func (s *StormServer) CreateWebhook(ctx context.Context, req *proto.CreateWebhookRequest) (*proto.Webhook, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	in := req.GetWebhook()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "webhook is required")
+	}
+	target, err := url.Parse(in.Url)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, status.Error(codes.InvalidArgument, "url must be an absolute http or https URL")
+	}
+	if !s.AllowPrivateWebhooks && webhooks.PrivateHost(target.Hostname()) {
+		return nil, status.Error(codes.InvalidArgument, "url must point to a public address")
+	}
+	if len(in.Url) > 2048 {
+		return nil, status.Error(codes.InvalidArgument, "url must be at most 2048 characters")
+	}
+	if !in.Alerts && !in.Storms && !in.Zones {
+		return nil, status.Error(codes.InvalidArgument, "at least one of alerts, storms or zones must be set")
+	}
+	if in.Region != "" {
+		if _, ok := s.Regions.Get(in.Region); !ok {
+			return nil, status.Errorf(codes.NotFound, "unknown region %q", in.Region)
+		}
+	}
+
+	existing, err := s.DB.ListWebhooks(ctx, claims.Subject)
+	if err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to list webhooks")
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+	if len(existing) >= maxWebhooks {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d webhooks per user", maxWebhooks)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Error().Err(err).Msg("Failed to generate webhook secret")
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+	hook := &models.Webhook{
+		UserID:    claims.Subject,
+		URL:       in.Url,
+		Secret:    hex.EncodeToString(secret),
+		Alerts:    in.Alerts,
+		Storms:    in.Storms,
+		Zones:     in.Zones,
+		Region:    in.Region,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.DB.CreateWebhook(ctx, hook); err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to create webhook")
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+	log.Info().Int64("webhook", hook.ID).Str("user", claims.Subject).Str("host", target.Host).Msg("Webhook created")
+	out := webhookToProto(hook)
+	out.Secret = hook.Secret
+	return out, nil
+}
+
+// ListWebhooks возвращает адреса текущего пользователя без секретов
+func (s *StormServer) ListWebhooks(ctx context.Context, req *proto.ListWebhooksRequest) (*proto.ListWebhooksResponse, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	hooks, err := s.DB.ListWebhooks(ctx, claims.Subject)
+	if err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to list webhooks")
+		return nil, status.Error(codes.Internal, "failed to list webhooks")
+	}
+	resp := &proto.ListWebhooksResponse{}
+	for i := range hooks {
+		resp.Webhooks = append(resp.Webhooks, webhookToProto(&hooks[i]))
+	}
+	return resp, nil
+}
+
+// DeleteWebhook удаляет адрес текущего пользователя; ожидающие повтора доставки на него отбрасываются
+func (s *StormServer) DeleteWebhook(ctx context.Context, req *proto.DeleteWebhookRequest) (*proto.DeleteWebhookResponse, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	err := s.DB.DeleteWebhook(ctx, claims.Subject, req.Id)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "unknown webhook %d", req.Id)
+	}
+	if err != nil {
+		log.Error().Err(err).Int64("webhook", req.Id).Msg("Failed to delete webhook")
+		return nil, status.Error(codes.Internal, "failed to delete webhook")
+	}
+	log.Info().Int64("webhook", req.Id).Str("user", claims.Subject).Msg("Webhook deleted")
+	return &proto.DeleteWebhookResponse{}, nil
+}
+
+func webhookToProto(hook *models.Webhook) *proto.Webhook {
+	return &proto.Webhook{
+		Id:        hook.ID,
+		Url:       hook.URL,
+		Alerts:    hook.Alerts,
+		Storms:    hook.Storms,
+		Zones:     hook.Zones,
+		Region:    hook.Region,
+		CreatedAt: hook.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,502 @@
+package rabbit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"Storm-Hunt/storm-backend/keycloak"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/proto"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	wsAckWindow    = 64               // Сколько кадров с данными может ждать ack клиента
+	wsMaxPending   = 256              // Предел очереди неотправленных кадров медленного клиента
+	wsWriteTimeout = 10 * time.Second // Таймаут записи одного кадра
+	wsMaxMessage   = 4096             // Предельный размер сообщения клиента, байт
+
+	weatherChannel = "weather:" // weather:<регион> — замеры региона, как в StartStream
+	stormsChannel  = "storms:"  // storms:<регион> — события штормов региона
+	alertsChannel  = "alerts"   // Оповещения по правилам самого пользователя
+	zonesChannel   = "zones"    // Вход и выход из зон наблюдения пользователя
+)
+
+// Токен проверяется middleware.RequireHTTP, а не по cookie, поэтому чужой сайт
+// не может открыть сокет от имени пользователя и Origin можно не ограничивать
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 4096,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// Сообщение клиента: subscribe/unsubscribe канала, ack принятых кадров и ping
+type wsRequest struct {
+	Type    string `json:"type"`
+	ID      string `json:"id,omitempty"` // Возвращается в ответе, чтобы клиент сопоставил его с запросом
+	Channel string `json:"channel,omitempty"`
+	Seq     uint64 `json:"seq,omitempty"` // Для ack: подтверждены все кадры до seq включительно
+
+	ResumeFrom uint64 `json:"resume_from,omitempty"` // Для subscribe на weather: sequence последнего полученного замера
+}
+
+// Кадр сервера
+type wsFrame struct {
+	Type    string          `json:"type"` // subscribed, unsubscribed, weather, storm, alert, zone, pong, error
+	ID      string          `json:"id,omitempty"`
+	Channel string          `json:"channel,omitempty"`
+	Seq     uint64          `json:"seq,omitempty"`   // Номер кадра с данными для ack
+	Event   string          `json:"event,omitempty"` // Для storm: opened, updated или closed; для alert: firing или resolved; для zone: enter или exit
+	Data    json.RawMessage `json:"data,omitempty"`
+	Reason  string          `json:"reason,omitempty"` // Почему сервер сам закрыл подписку (stopped) или соединение (overflow)
+	Error   string          `json:"error,omitempty"`
+}
+
+// Очередь событий переполнена: клиент должен переподключиться и запросить пропущенное через REST
+var errWSOverflow = errors.New("too many undelivered events")
+
+// Подписка сокета на один канал
+type wsSubscription struct {
+	channel string
+	cancel  context.CancelFunc
+}
+
+// Событие шторма из канала подписки
+type wsStormEvent struct {
+	channel string
+	event   models.StormEvent
+}
+
+// ServeWebSocket — шлюз для клиентов с множеством регионов на одном соединении (GET /v1/storm/ws).
+// Подписка weather:<регион> работает как StartStream (тот же хаб, учёт подписчиков и StopStream),
+// storms:<регион> передаёт события штормов, которые обнаруживает детектор, alerts — оповещения по правилам пользователя,
+// zones — вход и выход из его зон наблюдения.
+// Кадры с данными нумеруются seq; без ack клиент получает не больше wsAckWindow кадров,
+// пока он отстаёт, из замеров региона доставляется только самый свежий
+func (s *StormServer) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	claims, ok := keycloak.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "missing verified claims", http.StatusUnauthorized)
+		return
+	}
+	userID := claims.Subject
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Warn().Err(err).Str("user", userID).Msg("WebSocket upgrade failed")
+		return // Upgrader уже ответил клиенту
+	}
+	defer conn.Close()
+	log.Info().Str("user", userID).Msg("WebSocket connected")
+
+	// Соединение перестаёт подчиняться таймаутам REST-сервера
+	_ = conn.NetConn().SetDeadline(time.Time{})
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	ws := &wsSession{
+		server:   s,
+		conn:     conn,
+		ctx:      ctx,
+		userID:   userID,
+		subs:     make(map[string]*wsSubscription),
+		weather:  make(chan *proto.WeatherData),
+		storms:   make(chan wsStormEvent),
+		alerts:   make(chan models.AlertEvent),
+		zones:    make(chan models.ZoneEvent),
+		ended:    make(chan *wsSubscription),
+		incoming: make(chan wsRequest),
+	}
+	defer ws.closeAll()
+
+	go ws.read(cancel)
+	if err := ws.loop(); err != nil && ctx.Err() == nil {
+		log.Warn().Err(err).Str("user", userID).Msg("WebSocket closed with error")
+	}
+	log.Info().Str("user", userID).Msg("WebSocket disconnected")
+}
+
+// Состояние одного соединения; все поля, кроме каналов, принадлежат горутине loop
+type wsSession struct {
+	server *StormServer
+	conn   *websocket.Conn
+	ctx    context.Context
+	userID string
+
+	subs     map[string]*wsSubscription
+	weather  chan *proto.WeatherData // Замеры всех регионов сокета
+	storms   chan wsStormEvent       // События штормов всех каналов storms:
+	alerts   chan models.AlertEvent  // Оповещения пользователя
+	zones    chan models.ZoneEvent   // События зон пользователя
+	ended    chan *wsSubscription    // Подписки, завершённые сервером (StopStream, закрытие хаба)
+	incoming chan wsRequest
+
+	seq     uint64    // Номер последнего отправленного кадра с данными
+	acked   uint64    // Номер последнего подтверждённого кадра
+	pending []wsFrame // Кадры, ждущие места в окне ack
+}
+
+// Чтение сообщений клиента; ping протокола WebSocket продлевает срок ожидания
+func (ws *wsSession) read(cancel context.CancelFunc) {
+	defer cancel()
+	timeout := 2 * ws.server.KeepAlive
+	ws.conn.SetReadLimit(wsMaxMessage)
+	_ = ws.conn.SetReadDeadline(time.Now().Add(timeout))
+	ws.conn.SetPongHandler(func(string) error {
+		return ws.conn.SetReadDeadline(time.Now().Add(timeout))
+	})
+	for {
+		_, raw, err := ws.conn.ReadMessage()
+		if err != nil {
+			return // Клиент ушёл или соединение оборвалось
+		}
+		var req wsRequest
+		if err := json.Unmarshal(raw, &req); err != nil {
+			req = wsRequest{} // Ответим ошибкой, не закрывая соединение
+		}
+		_ = ws.conn.SetReadDeadline(time.Now().Add(timeout))
+		select {
+		case ws.incoming <- req:
+		case <-ws.ctx.Done():
+			return
+		}
+	}
+}
+
+func (ws *wsSession) loop() error {
+	ping := time.NewTicker(ws.server.KeepAlive)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-ws.ctx.Done():
+			return nil
+		case req := <-ws.incoming:
+			if err := ws.handle(req); err != nil {
+				return err
+			}
+		case data := <-ws.weather:
+			payload, err := sseMarshal.Marshal(data)
+			if err != nil {
+				log.Error().Err(err).Str("region", data.Region).Msg("Failed to encode weather frame")
+				continue
+			}
+			if err := ws.enqueue(wsFrame{Type: "weather", Channel: weatherChannel + data.Region, Data: payload}); err != nil {
+				return err
+			}
+		case ev := <-ws.storms:
+			item := stormToProto(&ev.event.Storm)
+			setForecast(item, ev.event.Forecast)
+			payload, err := sseMarshal.Marshal(item)
+			if err != nil {
+				log.Error().Err(err).Str("storm", ev.event.Storm.ID).Msg("Failed to encode storm frame")
+				continue
+			}
+			if err := ws.enqueue(wsFrame{Type: "storm", Channel: ev.channel, Event: ev.event.Event, Data: payload}); err != nil {
+				return err
+			}
+		case event := <-ws.alerts:
+			payload, err := sseMarshal.Marshal(alertEventToProto(&event))
+			if err != nil {
+				log.Error().Err(err).Int64("rule", event.RuleID).Msg("Failed to encode alert frame")
+				continue
+			}
+			if err := ws.enqueue(wsFrame{Type: "alert", Channel: alertsChannel, Event: event.State, Data: payload}); err != nil {
+				return err
+			}
+		case event := <-ws.zones:
+			payload, err := sseMarshal.Marshal(zoneEventToProto(&event))
+			if err != nil {
+				log.Error().Err(err).Int64("zone", event.ZoneID).Msg("Failed to encode zone frame")
+				continue
+			}
+			if err := ws.enqueue(wsFrame{Type: "zone", Channel: zonesChannel, Event: event.Event, Data: payload}); err != nil {
+				return err
+			}
+		case sub := <-ws.ended:
+			if ws.subs[sub.channel] != sub {
+				continue // Клиент уже отписался сам
+			}
+			delete(ws.subs, sub.channel)
+			if err := ws.write(wsFrame{Type: "unsubscribed", Channel: sub.channel, Reason: "stopped"}); err != nil {
+				return err
+			}
+		case <-ping.C:
+			_ = ws.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := ws.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return err
+			}
+		}
+		if err := ws.flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func (ws *wsSession) handle(req wsRequest) error {
+	switch req.Type {
+	case "subscribe":
+		if _, ok := ws.subs[req.Channel]; ok {
+			return ws.write(wsFrame{Type: "subscribed", ID: req.ID, Channel: req.Channel})
+		}
+		sub, err := ws.subscribe(req.Channel, req.ResumeFrom)
+		if err != nil {
+			return ws.write(wsFrame{Type: "error", ID: req.ID, Channel: req.Channel, Error: err.Error()})
+		}
+		ws.subs[req.Channel] = sub
+		return ws.write(wsFrame{Type: "subscribed", ID: req.ID, Channel: req.Channel})
+	case "unsubscribe":
+		if sub, ok := ws.subs[req.Channel]; ok {
+			delete(ws.subs, req.Channel)
+			sub.cancel()
+		}
+		return ws.write(wsFrame{Type: "unsubscribed", ID: req.ID, Channel: req.Channel})
+	case "ack":
+		if req.Seq > ws.seq {
+			return ws.write(wsFrame{Type: "error", ID: req.ID, Error: fmt.Sprintf("ack %d is ahead of last sent frame %d", req.Seq, ws.seq)})
+		}
+		if req.Seq > ws.acked {
+			ws.acked = req.Seq
+		}
+		return nil
+	case "ping":
+		return ws.write(wsFrame{Type: "pong", ID: req.ID})
+	case "":
+		return ws.write(wsFrame{Type: "error", ID: req.ID, Error: "message must be a JSON object with a type"})
+	default:
+		return ws.write(wsFrame{Type: "error", ID: req.ID, Error: fmt.Sprintf("unsupported message type %q", req.Type)})
+	}
+}
+
+// Открытие подписки на канал weather:<регион>, storms:<регион>, alerts или zones
+func (ws *wsSession) subscribe(channel string, resumeFrom uint64) (*wsSubscription, error) {
+	if channel == alertsChannel {
+		return ws.subscribeAlerts()
+	}
+	if channel == zonesChannel {
+		return ws.subscribeZones()
+	}
+	kind, region, _ := strings.Cut(channel, ":")
+	if kind+":" != weatherChannel && kind+":" != stormsChannel {
+		return nil, fmt.Errorf("unknown channel %q, expected weather:<region>, storms:<region>, alerts or zones", channel)
+	}
+	if _, ok := ws.server.Regions.Get(region); !ok {
+		return nil, fmt.Errorf("unknown region %q", region)
+	}
+	ctx, cancel := context.WithCancel(ws.ctx)
+	sub := &wsSubscription{channel: channel, cancel: cancel}
+
+	switch kind + ":" {
+	case weatherChannel:
+		feed, err := ws.server.openFeed(ctx, region, ws.userID, resumeFrom)
+		if err != nil {
+			cancel()
+			return nil, errors.New(status.Convert(err).Message())
+		}
+		untrack := ws.server.trackStream(region, &activeStream{userID: ws.userID, cancel: cancel})
+		go func() {
+			defer ws.finish(sub)
+			defer untrack()
+			defer feed.close()
+			ws.server.relay(ctx, feed, ws.weather)
+		}()
+	case stormsChannel:
+		events, err := ws.server.StormHub.Subscribe(ctx, region)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to subscribe to storm events: %v", err)
+		}
+		go func() {
+			defer ws.finish(sub)
+			defer events.Close()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case payload, ok := <-events.Updates():
+					if !ok {
+						return
+					}
+					var event models.StormEvent
+					if err := json.Unmarshal([]byte(payload), &event); err != nil {
+						log.Error().Err(err).Str("region", region).Msg("Failed to decode storm event")
+						continue
+					}
+					select {
+					case ws.storms <- wsStormEvent{channel: channel, event: event}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+	return sub, nil
+}
+
+// Подписка на оповещения пользователя; канал Redis хаба — alert_events:<subject>
+func (ws *wsSession) subscribeAlerts() (*wsSubscription, error) {
+	ctx, cancel := context.WithCancel(ws.ctx)
+	sub := &wsSubscription{channel: alertsChannel, cancel: cancel}
+	events, err := ws.server.AlertHub.Subscribe(ctx, ws.userID)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe to alerts: %v", err)
+	}
+	go func() {
+		defer ws.finish(sub)
+		defer events.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-events.Updates():
+				if !ok {
+					return
+				}
+				var event models.AlertEvent
+				if err := json.Unmarshal([]byte(payload), &event); err != nil {
+					log.Error().Err(err).Str("user", ws.userID).Msg("Failed to decode alert event")
+					continue
+				}
+				select {
+				case ws.alerts <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// Подписка на события зон пользователя; канал Redis хаба — zone_events:<subject>
+func (ws *wsSession) subscribeZones() (*wsSubscription, error) {
+	ctx, cancel := context.WithCancel(ws.ctx)
+	sub := &wsSubscription{channel: zonesChannel, cancel: cancel}
+	events, err := ws.server.ZoneHub.Subscribe(ctx, ws.userID)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe to zones: %v", err)
+	}
+	go func() {
+		defer ws.finish(sub)
+		defer events.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-events.Updates():
+				if !ok {
+					return
+				}
+				var event models.ZoneEvent
+				if err := json.Unmarshal([]byte(payload), &event); err != nil {
+					log.Error().Err(err).Str("user", ws.userID).Msg("Failed to decode zone event")
+					continue
+				}
+				select {
+				case ws.zones <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// Сообщение loop о завершении подписки
+func (ws *wsSession) finish(sub *wsSubscription) {
+	sub.cancel()
+	select {
+	case ws.ended <- sub:
+	case <-ws.ctx.Done():
+	}
+}
+
+func (ws *wsSession) closeAll() {
+	for channel, sub := range ws.subs {
+		sub.cancel()
+		delete(ws.subs, channel)
+	}
+}
+
+// Постановка кадра с данными в очередь. Замер региона заменяет ещё не отправленный
+// замер того же канала: медленному клиенту важнее свежие данные, чем все промежуточные.
+// При полной очереди место освобождают только замеры — следующий опрос их восполнит.
+// События штормов, оповещений и зон не выбрасываются: если вытеснить нечего, сокет закрывается
+func (ws *wsSession) enqueue(frame wsFrame) error {
+	if frame.Type == "weather" {
+		for i := range ws.pending {
+			if ws.pending[i].Type == frame.Type && ws.pending[i].Channel == frame.Channel {
+				ws.pending[i] = frame
+				return nil
+			}
+		}
+	}
+	if len(ws.pending) < wsMaxPending {
+		ws.pending = append(ws.pending, frame)
+		return nil
+	}
+
+	for i := range ws.pending {
+		if ws.pending[i].Type == "weather" {
+			log.Warn().Str("user", ws.userID).Str("channel", ws.pending[i].Channel).Msg("Slow WebSocket client, dropped weather frame")
+			ws.pending = append(ws.pending[:i], ws.pending[i+1:]...)
+			ws.pending = append(ws.pending, frame)
+			return nil
+		}
+	}
+	if frame.Type == "weather" {
+		log.Warn().Str("user", ws.userID).Str("channel", frame.Channel).Msg("Slow WebSocket client, dropped weather frame")
+		return nil
+	}
+	return ws.overflow(frame.Channel)
+}
+
+// Закрытие сокета, который не успевает принимать события: кадр error с reason overflow,
+// затем кадр закрытия 1013 (try again later), чтобы клиент переподключился
+func (ws *wsSession) overflow(channel string) error {
+	log.Warn().Str("user", ws.userID).Str("channel", channel).Int("pending", len(ws.pending)).Msg("Slow WebSocket client, event queue overflowed")
+	err := ws.write(wsFrame{Type: "error", Channel: channel, Reason: "overflow", Error: "too many unacknowledged events, reconnect and resubscribe"})
+	if err == nil {
+		closing := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "overflow")
+		err = ws.conn.WriteControl(websocket.CloseMessage, closing, time.Now().Add(wsWriteTimeout))
+	}
+	if err != nil {
+		return err
+	}
+	return errWSOverflow
+}
+
+// Отправка ожидающих кадров, пока позволяет окно ack
+func (ws *wsSession) flush() error {
+	for len(ws.pending) > 0 && ws.seq-ws.acked < wsAckWindow {
+		frame := ws.pending[0]
+		ws.pending = ws.pending[1:]
+		if _, ok := ws.subs[frame.Channel]; !ok {
+			continue // Канал закрыт, пока кадр ждал в очереди
+		}
+		ws.seq++
+		frame.Seq = ws.seq
+		if err := ws.write(frame); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ws *wsSession) write(frame wsFrame) error {
+	_ = ws.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return ws.conn.WriteJSON(frame)
+}
@@ -0,0 +1,144 @@
+package rabbit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/keycloak"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/proto"
+	"Storm-Hunt/storm-backend/zones"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxWatchZones = 20 // Зон у одного пользователя
+
+// CreateWatchZone сохраняет зону наблюдения текущего пользователя
+func (s *StormServer) CreateWatchZone(ctx context.Context, req *proto.CreateWatchZoneRequest) (*proto.WatchZone, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	in := req.GetZone()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "zone is required")
+	}
+	name := strings.TrimSpace(in.Name)
+	if name == "" || len(name) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "name must be 1 to 100 characters")
+	}
+
+	zone := &models.WatchZone{
+		UserID:     claims.Subject,
+		Name:       name,
+		MinWindKmH: in.MinWindKmh,
+		CreatedAt:  time.Now().UTC(),
+	}
+	switch {
+	case len(in.Polygon) > 0 && (in.Center != nil || in.RadiusKm != 0):
+		return nil, status.Error(codes.InvalidArgument, "set either polygon or center and radius_km, not both")
+	case len(in.Polygon) > 0:
+		zone.Shape = models.ZonePolygon
+		for _, p := range in.Polygon {
+			zone.Polygon = append(zone.Polygon, geo.Point{Lat: p.Lat, Lon: p.Lon})
+		}
+	case in.Center != nil:
+		zone.Shape = models.ZoneCircle
+		zone.Center = geo.Point{Lat: in.Center.Lat, Lon: in.Center.Lon}
+		zone.RadiusKm = in.RadiusKm
+	default:
+		return nil, status.Error(codes.InvalidArgument, "polygon or center and radius_km are required")
+	}
+	if err := zones.Prepare(zone); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	existing, err := s.DB.ListWatchZones(ctx, claims.Subject)
+	if err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to list watch zones")
+		return nil, status.Error(codes.Internal, "failed to create watch zone")
+	}
+	if len(existing) >= maxWatchZones {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d watch zones per user", maxWatchZones)
+	}
+	if err := s.DB.CreateWatchZone(ctx, zone); err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to create watch zone")
+		return nil, status.Error(codes.Internal, "failed to create watch zone")
+	}
+	log.Info().Int64("zone", zone.ID).Str("user", claims.Subject).Str("shape", zone.Shape).Msg("Watch zone created")
+	return watchZoneToProto(zone), nil
+}
+
+// ListWatchZones возвращает зоны текущего пользователя
+func (s *StormServer) ListWatchZones(ctx context.Context, req *proto.ListWatchZonesRequest) (*proto.ListWatchZonesResponse, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	list, err := s.DB.ListWatchZones(ctx, claims.Subject)
+	if err != nil {
+		log.Error().Err(err).Str("user", claims.Subject).Msg("Failed to list watch zones")
+		return nil, status.Error(codes.Internal, "failed to list watch zones")
+	}
+	resp := &proto.ListWatchZonesResponse{}
+	for i := range list {
+		resp.Zones = append(resp.Zones, watchZoneToProto(&list[i]))
+	}
+	return resp, nil
+}
+
+// DeleteWatchZone удаляет зону текущего пользователя; выход из удалённой зоны не сообщается
+func (s *StormServer) DeleteWatchZone(ctx context.Context, req *proto.DeleteWatchZoneRequest) (*proto.DeleteWatchZoneResponse, error) {
+	claims, ok := keycloak.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing verified claims")
+	}
+	err := s.DB.DeleteWatchZone(ctx, claims.Subject, req.Id)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "unknown watch zone %d", req.Id)
+	}
+	if err != nil {
+		log.Error().Err(err).Int64("zone", req.Id).Msg("Failed to delete watch zone")
+		return nil, status.Error(codes.Internal, "failed to delete watch zone")
+	}
+	log.Info().Int64("zone", req.Id).Str("user", claims.Subject).Msg("Watch zone deleted")
+	return &proto.DeleteWatchZoneResponse{}, nil
+}
+
+func watchZoneToProto(zone *models.WatchZone) *proto.WatchZone {
+	out := &proto.WatchZone{
+		Id:         zone.ID,
+		Name:       zone.Name,
+		MinWindKmh: zone.MinWindKmH,
+		CreatedAt:  zone.CreatedAt.Format(time.RFC3339),
+	}
+	if zone.Shape == models.ZoneCircle {
+		out.Center = &proto.LatLon{Lat: zone.Center.Lat, Lon: zone.Center.Lon}
+		out.RadiusKm = zone.RadiusKm
+	}
+	for _, p := range zone.Polygon {
+		out.Polygon = append(out.Polygon, &proto.LatLon{Lat: p.Lat, Lon: p.Lon})
+	}
+	return out
+}
+
+func zoneEventToProto(event *models.ZoneEvent) *proto.WatchZoneEvent {
+	return &proto.WatchZoneEvent{
+		ZoneId:     event.ZoneID,
+		ZoneName:   event.ZoneName,
+		Event:      event.Event,
+		Source:     event.Source,
+		StormId:    event.StormID,
+		Region:     event.Region,
+		Position:   &proto.LatLon{Lat: event.Lat, Lon: event.Lon},
+		WindKmh:    event.WindKmH,
+		OccurredAt: event.OccurredAt.Format(time.RFC3339),
+	}
+}
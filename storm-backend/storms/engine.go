@@ -0,0 +1,475 @@
+package storms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"Storm-Hunt/storm-backend/catalog"
+	"Storm-Hunt/storm-backend/classify"
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/forecast"
+	"Storm-Hunt/storm-backend/intercept"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/subscription"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// Ключи Redis, общие для всех реплик: циклы одного региона могут обрабатывать разные реплики
+const (
+	stateKey = "storm:detector:%s"      // HASH состояния детектора региона
+	lockKey  = "storm:detector-lock:%s" // Блокировка на время обработки цикла
+	lockTTL  = 10 * time.Second
+
+	reapEvery = time.Minute // Период проверки штормов, по которым перестали приходить циклы
+	holdEvery = time.Minute // Как часто сверять регионы с webhooks штормов и удерживаемые подписки
+)
+
+// Каналы pub/sub событий штормов: EventsPrefix + регион
+const EventsPrefix = "storm_events:"
+
+// Снятие блокировки, только если она всё ещё наша
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// Пороги детектора
+type Config struct {
+	WindThreshold   float32 // Ветер, км/ч, с которого цикл считается штормовым
+	SustainedCycles int     // Сколько штормовых циклов подряд открывают шторм
+	QuietCycles     int     // Сколько спокойных циклов подряд закрывают шторм
+}
+
+// Пороги из STORM_WIND_THRESHOLD (62 км/ч — штормовой ветер по Бофорту), STORM_SUSTAINED_CYCLES (2) и STORM_QUIET_CYCLES (3)
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{WindThreshold: 62, SustainedCycles: 2, QuietCycles: 3}
+	if raw := os.Getenv("STORM_WIND_THRESHOLD"); raw != "" {
+		threshold, err := strconv.ParseFloat(raw, 32)
+		if err != nil || threshold <= 0 {
+			return cfg, fmt.Errorf("invalid STORM_WIND_THRESHOLD %q", raw)
+		}
+		cfg.WindThreshold = float32(threshold)
+	}
+	for name, target := range map[string]*int{
+		"STORM_SUSTAINED_CYCLES": &cfg.SustainedCycles,
+		"STORM_QUIET_CYCLES":     &cfg.QuietCycles,
+	} {
+		if raw := os.Getenv(name); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 1 {
+				return cfg, fmt.Errorf("invalid %s %q", name, raw)
+			}
+			*target = n
+		}
+	}
+	return cfg, nil
+}
+
+// Детектор штормов: открывает шторм при устойчивом сильном ветре, ведёт трек и пик,
+// закрывает шторм после нескольких спокойных циклов или когда циклы перестали приходить
+type Engine struct {
+	store      database.Store
+	rdb        *redis.Client
+	cfg        Config
+	thresholds *classify.Thresholds // Классификация пика шторма
+
+	Notifier Notifier         // Необязательная внешняя доставка событий (webhooks)
+	Regions  *catalog.Catalog // Интервалы опроса регионов; без каталога штормы закрываются только спокойными циклами
+
+	Subscriptions *subscription.Registry // Если задан вместе с Regions, регионы с webhooks штормов опрашиваются и без клиентов
+}
+
+// Получатель событий штормов помимо Redis, например очередь webhooks
+type Notifier interface {
+	NotifyStorm(ctx context.Context, event models.StormEvent)
+}
+
+func NewEngine(store database.Store, rdb *redis.Client, cfg Config, thresholds *classify.Thresholds) *Engine {
+	return &Engine{store: store, rdb: rdb, cfg: cfg, thresholds: thresholds}
+}
+
+// Состояние детектора региона между циклами
+type state struct {
+	Above     int    // Штормовых циклов подряд до открытия шторма
+	Since     int64  // Начало серии штормовых циклов (unix ms)
+	StormID   string // Открытый шторм региона
+	Quiet     int    // Спокойных циклов подряд у открытого шторма
+	LastWindy int64  // Последний штормовой цикл (unix ms)
+	Last      int64  // Последний обработанный цикл (unix ms)
+}
+
+// Обработка одного цикла опроса региона
+func (e *Engine) Observe(ctx context.Context, cycle models.ObservationCycle) error {
+	if len(cycle.Observations) == 0 {
+		return nil
+	}
+	unlock, err := e.lock(ctx, cycle.Region)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	st, err := e.load(ctx, cycle.Region)
+	if err != nil {
+		return err
+	}
+	at := cycle.FetchedAt.UTC()
+	if at.UnixMilli() <= st.Last { // Повтор или опоздавший цикл не меняет состояние
+		return nil
+	}
+	if e.stale(cycle.Region, st, at) { // Опрос возобновился после долгого перерыва — прежний шторм закончился
+		if err := e.expire(ctx, st, at); err != nil {
+			return err
+		}
+	}
+	st.Last = at.UnixMilli()
+
+	strongest := cycle.Observations[0]
+	for _, obs := range cycle.Observations[1:] {
+		if obs.WindKmH > strongest.WindKmH {
+			strongest = obs
+		}
+	}
+	windy := strongest.WindKmH >= e.cfg.WindThreshold
+	point := models.TrackPoint{
+		ObservedAt: strongest.ObservedAt,
+		Point:      strongest.Point,
+		Lat:        strongest.Lat,
+		Lon:        strongest.Lon,
+		WindKmH:    strongest.WindKmH,
+		Pressure:   strongest.Pressure,
+	}
+
+	if st.StormID == "" {
+		err = e.watch(ctx, cycle.Region, at, windy, point, st)
+	} else {
+		err = e.track(ctx, at, windy, point, st)
+	}
+	if err != nil {
+		return err
+	}
+	return e.save(ctx, cycle.Region, st)
+}
+
+// Регион без шторма: считаем штормовые циклы и открываем шторм, когда ветер держится
+func (e *Engine) watch(ctx context.Context, region string, at time.Time, windy bool, point models.TrackPoint, st *state) error {
+	if !windy {
+		st.Above, st.Since = 0, 0
+		return nil
+	}
+	if st.Above == 0 {
+		st.Since = at.UnixMilli()
+	}
+	st.Above++
+	if st.Above < e.cfg.SustainedCycles {
+		return nil
+	}
+
+	started := time.UnixMilli(st.Since).UTC()
+	storm := &models.Storm{
+		ID:          stormID(region, started),
+		Region:      region,
+		Status:      models.StormOpen,
+		StartedAt:   started,
+		UpdatedAt:   at,
+		Lat:         point.Lat,
+		Lon:         point.Lon,
+		WindKmH:     point.WindKmH,
+		PeakWindKmH: point.WindKmH,
+		PeakAt:      point.ObservedAt,
+		MinPressure: point.Pressure,
+	}
+	e.classifyPeak(storm, point)
+	if err := e.store.CreateStorm(ctx, storm); err != nil {
+		return err
+	}
+	if err := e.store.AddTrackPoint(ctx, storm.ID, point); err != nil {
+		return err
+	}
+	log.Info().Str("storm", storm.ID).Str("region", region).Float32("wind_kmh", point.WindKmH).Msg("Storm opened")
+	e.publish(ctx, models.StormEvent{Event: models.StormEventOpened, Storm: *storm, Point: &point})
+
+	*st = state{StormID: storm.ID, LastWindy: at.UnixMilli(), Last: st.Last}
+	return nil
+}
+
+// Открытый шторм: пополняем трек и пик, закрываем после QuietCycles спокойных циклов
+func (e *Engine) track(ctx context.Context, at time.Time, windy bool, point models.TrackPoint, st *state) error {
+	storm, err := e.store.GetStorm(ctx, st.StormID)
+	if errors.Is(err, database.ErrNotFound) { // Шторм удалён из базы — начинаем наблюдение заново
+		log.Warn().Str("storm", st.StormID).Msg("Open storm is missing from the database, resetting detector")
+		*st = state{Last: st.Last}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	storm.UpdatedAt = at
+	if windy {
+		st.Quiet = 0
+		st.LastWindy = at.UnixMilli()
+		if err := e.store.AddTrackPoint(ctx, storm.ID, point); err != nil {
+			return err
+		}
+		storm.Lat, storm.Lon, storm.WindKmH = point.Lat, point.Lon, point.WindKmH
+		if point.WindKmH > storm.PeakWindKmH {
+			storm.PeakWindKmH, storm.PeakAt = point.WindKmH, point.ObservedAt
+			e.classifyPeak(storm, point)
+		}
+		if point.Pressure != 0 && (storm.MinPressure == 0 || point.Pressure < storm.MinPressure) {
+			storm.MinPressure = point.Pressure
+		}
+	} else {
+		st.Quiet++
+		storm.WindKmH = point.WindKmH
+	}
+
+	closed := st.Quiet >= e.cfg.QuietCycles
+	if closed {
+		ended := time.UnixMilli(st.LastWindy).UTC() // Шторм закончился с последним штормовым циклом
+		storm.Status = models.StormClosed
+		storm.EndedAt = &ended
+	}
+	if err := e.store.UpdateStorm(ctx, storm); err != nil {
+		return err
+	}
+	event := models.StormEvent{Event: models.StormEventUpdated, Storm: *storm}
+	if windy {
+		event.Point = &point
+	}
+	if closed {
+		log.Info().Str("storm", storm.ID).Str("region", storm.Region).Float32("peak_wind_kmh", storm.PeakWindKmH).Msg("Storm closed")
+		*st = state{Last: st.Last}
+		event.Event = models.StormEventClosed
+	} else {
+		event.Forecast = e.forecast(ctx, storm.ID)
+	}
+	e.publish(ctx, event)
+	return nil
+}
+
+// Фоновое закрытие штормов регионов, опрос которых остановлен: без циклов Observe их не закроет
+func (e *Engine) Run(ctx context.Context) {
+	if e.Regions == nil {
+		return
+	}
+	if e.Subscriptions != nil {
+		done := make(chan struct{})
+		defer func() { <-done }()
+		go func() {
+			defer close(done)
+			e.Subscriptions.Hold(ctx, "system:storms", holdEvery, e.webhookRegions)
+		}()
+	}
+	ticker := time.NewTicker(reapEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, region := range e.Regions.Regions() {
+				if err := e.reap(ctx, region.ID, time.Now().UTC()); err != nil {
+					log.Error().Err(err).Str("region", region.ID).Msg("Failed to close stale storm")
+				}
+			}
+		}
+	}
+}
+
+// Регионы, о штормах которых ждут webhooks: без опроса шторм в них не обнаружить
+func (e *Engine) webhookRegions(ctx context.Context) ([]string, error) {
+	var needed []string
+	for _, region := range e.Regions.Regions() {
+		hooks, err := e.store.StormWebhooks(ctx, region.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(hooks) > 0 {
+			needed = append(needed, region.ID)
+		}
+	}
+	return needed, nil
+}
+
+// Закрытие открытого шторма региона, если циклов не было дольше допустимого
+func (e *Engine) reap(ctx context.Context, region string, now time.Time) error {
+	st, err := e.load(ctx, region)
+	if err != nil || !e.stale(region, st, now) {
+		return err
+	}
+	unlock, err := e.lock(ctx, region)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	st, err = e.load(ctx, region) // Цикл мог прийти, пока ждали блокировку
+	if err != nil || !e.stale(region, st, now) {
+		return err
+	}
+	if err := e.expire(ctx, st, now); err != nil {
+		return err
+	}
+	return e.save(ctx, region, st)
+}
+
+// Открытый шторм без циклов дольше QuietCycles интервалов опроса (с запасом в половину
+// интервала на задержки опроса): пропущенные циклы считаются спокойными
+func (e *Engine) stale(region string, st *state, now time.Time) bool {
+	if st.StormID == "" || st.Last == 0 || e.Regions == nil {
+		return false
+	}
+	r, ok := e.Regions.Get(region)
+	if !ok {
+		return false
+	}
+	deadline := time.Duration(e.cfg.QuietCycles)*r.Interval + r.Interval/2
+	return now.Sub(time.UnixMilli(st.Last)) > deadline
+}
+
+// Закрытие шторма, по которому перестали приходить циклы. Конец шторма — время
+// последней точки трека: после неё ветра никто не видел
+func (e *Engine) expire(ctx context.Context, st *state, now time.Time) error {
+	storm, err := e.store.GetStorm(ctx, st.StormID)
+	if errors.Is(err, database.ErrNotFound) {
+		log.Warn().Str("storm", st.StormID).Msg("Open storm is missing from the database, resetting detector")
+		*st = state{Last: st.Last}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	track, err := e.store.GetTrack(ctx, storm.ID)
+	if err != nil {
+		return err
+	}
+
+	ended := time.UnixMilli(st.LastWindy).UTC()
+	if len(track) > 0 {
+		ended = track[len(track)-1].ObservedAt.UTC()
+	}
+	storm.Status = models.StormClosed
+	storm.EndedAt = &ended
+	storm.UpdatedAt = now
+	if err := e.store.UpdateStorm(ctx, storm); err != nil {
+		return err
+	}
+	log.Info().Str("storm", storm.ID).Str("region", storm.Region).Time("last_cycle", time.UnixMilli(st.Last)).Msg("No cycles for the storm, closing it")
+	*st = state{Last: st.Last}
+	e.publish(ctx, models.StormEvent{Event: models.StormEventClosed, Storm: *storm})
+	return nil
+}
+
+// Прогноз движения открытого шторма для события; без трека событие уходит без прогноза
+func (e *Engine) forecast(ctx context.Context, stormID string) *models.StormForecast {
+	track, err := e.store.GetTrack(ctx, stormID)
+	if err != nil {
+		log.Warn().Err(err).Str("storm", stormID).Msg("Failed to read storm track for forecast")
+		return nil
+	}
+	return forecast.Project(track, intercept.DefaultTrackPoints)
+}
+
+// Публикация события шторма для потоков клиентов. Ошибка только логируется:
+// шторм уже сохранён в базе, а клиенты прочитают его через ListStorms
+func (e *Engine) publish(ctx context.Context, event models.StormEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Str("storm", event.Storm.ID).Msg("Failed to encode storm event")
+		return
+	}
+	if err := e.rdb.Publish(ctx, EventsPrefix+event.Storm.Region, payload).Err(); err != nil {
+		log.Error().Err(err).Str("storm", event.Storm.ID).Msg("Failed to publish storm event")
+	}
+	if e.Notifier != nil {
+		e.Notifier.NotifyStorm(ctx, event)
+	}
+}
+
+// Классификация шторма по пиковому ветру в точке пика
+func (e *Engine) classifyPeak(storm *models.Storm, peak models.TrackPoint) {
+	c := e.thresholds.Classify(peak.WindKmH, peak.Lat)
+	storm.Beaufort, storm.Category, storm.Severity = c.Beaufort, c.Category, int32(c.Severity)
+}
+
+// Стабильный идентификатор: регион и время начала, например atlantic-20261017T120000Z
+func stormID(region string, started time.Time) string {
+	return strings.ToLower(region) + "-" + started.Format("20060102T150405Z")
+}
+
+func (e *Engine) load(ctx context.Context, region string) (*state, error) {
+	values, err := e.rdb.HGetAll(ctx, fmt.Sprintf(stateKey, region)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load detector state for %s: %w", region, err)
+	}
+	st := &state{StormID: values["storm"]}
+	st.Above, _ = strconv.Atoi(values["above"])
+	st.Quiet, _ = strconv.Atoi(values["quiet"])
+	st.Since, _ = strconv.ParseInt(values["since"], 10, 64)
+	st.LastWindy, _ = strconv.ParseInt(values["last_windy"], 10, 64)
+	st.Last, _ = strconv.ParseInt(values["last"], 10, 64)
+	return st, nil
+}
+
+func (e *Engine) save(ctx context.Context, region string, st *state) error {
+	err := e.rdb.HSet(ctx, fmt.Sprintf(stateKey, region),
+		"above", st.Above,
+		"since", st.Since,
+		"storm", st.StormID,
+		"quiet", st.Quiet,
+		"last_windy", st.LastWindy,
+		"last", st.Last,
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to save detector state for %s: %w", region, err)
+	}
+	return nil
+}
+
+// Блокировка региона, чтобы циклы одного региона не обрабатывались параллельно в разных репликах
+func (e *Engine) lock(ctx context.Context, region string) (func(), error) {
+	key := fmt.Sprintf(lockKey, region)
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	token := hex.EncodeToString(buf)
+
+	deadline := time.Now().Add(lockTTL)
+	for {
+		ok, err := e.rdb.SetNX(ctx, key, token, lockTTL).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock detector for %s: %w", region, err)
+		}
+		if ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for detector lock of %s", region)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	return func() {
+		if err := unlockScript.Run(context.Background(), e.rdb, []string{key}, token).Err(); err != nil && err != redis.Nil {
+			log.Error().Err(err).Str("region", region).Msg("Failed to unlock storm detector")
+		}
+	}, nil
+}
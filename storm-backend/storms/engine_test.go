@@ -0,0 +1,152 @@
+package storms
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"Storm-Hunt/storm-backend/catalog"
+	"Storm-Hunt/storm-backend/classify"
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/models"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Хранилище только с тем, что нужно детектору при закрытии шторма
+type fakeStore struct {
+	database.Store
+	storms map[string]*models.Storm
+	tracks map[string][]models.TrackPoint
+}
+
+func (s *fakeStore) GetStorm(ctx context.Context, id string) (*models.Storm, error) {
+	storm, ok := s.storms[id]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	copied := *storm
+	return &copied, nil
+}
+
+func (s *fakeStore) GetTrack(ctx context.Context, stormID string) ([]models.TrackPoint, error) {
+	return s.tracks[stormID], nil
+}
+
+func (s *fakeStore) UpdateStorm(ctx context.Context, storm *models.Storm) error {
+	copied := *storm
+	s.storms[storm.ID] = &copied
+	return nil
+}
+
+type recordingNotifier struct {
+	events []models.StormEvent
+}
+
+func (n *recordingNotifier) NotifyStorm(ctx context.Context, event models.StormEvent) {
+	n.events = append(n.events, event)
+}
+
+// Детектор с каталогом из одного региона с интервалом опроса 10 минут.
+// Redis недоступен: публикация только логирует ошибку, события видны через Notifier
+func newTestEngine(t *testing.T, store database.Store) (*Engine, *recordingNotifier) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "regions.yaml")
+	yaml := "regions:\n  - id: Atlantic\n    interval: 10m\n    points:\n      - {name: Miami, lat: 25.76, lon: -80.19}\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	regions, err := catalog.Load(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+	t.Cleanup(func() { rdb.Close() })
+	notifier := &recordingNotifier{}
+	e := NewEngine(store, rdb, Config{WindThreshold: 62, SustainedCycles: 2, QuietCycles: 3}, classify.Default())
+	e.Regions = regions
+	e.Notifier = notifier
+	return e, notifier
+}
+
+func TestStale(t *testing.T) {
+	e, _ := newTestEngine(t, &fakeStore{})
+	last := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+	open := &state{StormID: "atlantic-20260901T110000Z", Last: last.UnixMilli()}
+
+	tests := []struct {
+		name   string
+		region string
+		st     *state
+		now    time.Time
+		want   bool
+	}{
+		{"next cycle on time", "Atlantic", open, last.Add(10 * time.Minute), false},
+		// Три пропущенных цикла — ещё в пределах запаса в полинтервала
+		{"quiet cycles plus slack", "Atlantic", open, last.Add(35 * time.Minute), false},
+		{"no cycles for longer", "Atlantic", open, last.Add(36 * time.Minute), true},
+		{"no open storm", "Atlantic", &state{Last: last.UnixMilli()}, last.Add(24 * time.Hour), false},
+		{"no cycles yet", "Atlantic", &state{StormID: open.StormID}, last.Add(24 * time.Hour), false},
+		{"region not in the catalogue", "Pacific", open, last.Add(24 * time.Hour), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := e.stale(tt.region, tt.st, tt.now); got != tt.want {
+				t.Errorf("stale = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	e.Regions = nil
+	if e.stale("Atlantic", open, last.Add(24*time.Hour)) {
+		t.Error("storm went stale without a region catalogue")
+	}
+}
+
+func TestExpire(t *testing.T) {
+	started := time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC)
+	lastPoint := started.Add(50 * time.Minute) // Замер последней точки раньше цикла, в котором он пришёл
+	store := &fakeStore{
+		storms: map[string]*models.Storm{"atlantic-20260901T100000Z": {
+			ID: "atlantic-20260901T100000Z", Region: "Atlantic", Status: models.StormOpen, StartedAt: started, PeakWindKmH: 90,
+		}},
+		tracks: map[string][]models.TrackPoint{"atlantic-20260901T100000Z": {
+			{ObservedAt: started, WindKmH: 70},
+			{ObservedAt: lastPoint, WindKmH: 90},
+		}},
+	}
+	e, notifier := newTestEngine(t, store)
+
+	lastCycle := started.Add(time.Hour)
+	st := &state{StormID: "atlantic-20260901T100000Z", LastWindy: lastCycle.UnixMilli(), Last: lastCycle.UnixMilli()}
+	now := lastCycle.Add(2 * time.Hour)
+	if !e.stale("Atlantic", st, now) {
+		t.Fatal("storm is not stale")
+	}
+	if err := e.expire(context.Background(), st, now); err != nil {
+		t.Fatalf("expire: %v", err)
+	}
+
+	storm := store.storms["atlantic-20260901T100000Z"]
+	if storm.Status != models.StormClosed || storm.EndedAt == nil || !storm.EndedAt.Equal(lastPoint) || !storm.UpdatedAt.Equal(now) {
+		t.Errorf("storm = %s, ended at %v, updated at %v; want closed at %v", storm.Status, storm.EndedAt, storm.UpdatedAt, lastPoint)
+	}
+	if *st != (state{Last: lastCycle.UnixMilli()}) {
+		t.Errorf("state = %+v, want a reset detector", *st)
+	}
+	if len(notifier.events) != 1 || notifier.events[0].Event != models.StormEventClosed || notifier.events[0].Storm.EndedAt == nil {
+		t.Errorf("events = %+v", notifier.events)
+	}
+
+	// Шторм, удалённый из базы, только сбрасывает детектор
+	st = &state{StormID: "atlantic-gone", Last: lastCycle.UnixMilli()}
+	if err := e.expire(context.Background(), st, now); err != nil {
+		t.Fatalf("expire: %v", err)
+	}
+	if st.StormID != "" || len(notifier.events) != 1 {
+		t.Errorf("state = %+v, events = %d", *st, len(notifier.events))
+	}
+}
@@ -0,0 +1,67 @@
+package subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Удержание опроса регионов, которые нужны фоновым проверкам (правила оповещений, зоны наблюдения),
+// даже когда их не смотрит ни один клиент. Раз в every список wanted сверяется с удерживаемыми
+// регионами: для нового региона берётся подписка от имени owner, для ненужного — снимается.
+// Блокирует до отмены контекста, после чего снимает все подписки
+func (r *Registry) Hold(ctx context.Context, owner string, every time.Duration, wanted func(ctx context.Context) ([]string, error)) {
+	held := make(map[string]*Subscription)
+	defer func() {
+		for _, sub := range held {
+			sub.Release()
+		}
+	}()
+
+	sync := func() {
+		regions, err := wanted(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Error().Err(err).Str("owner", owner).Msg("Failed to list regions to keep polled")
+			}
+			return // Удерживаемые подписки остаются до следующей успешной проверки
+		}
+		want := make(map[string]bool, len(regions))
+		for _, region := range regions {
+			want[region] = true
+		}
+
+		for region, sub := range held {
+			if !want[region] {
+				sub.Release()
+				delete(held, region)
+				log.Info().Str("region", region).Str("owner", owner).Msg("Region no longer needs background polling")
+			}
+		}
+		for region := range want {
+			if held[region] != nil {
+				continue
+			}
+			sub, err := r.Acquire(ctx, region, owner)
+			if err != nil {
+				log.Error().Err(err).Str("region", region).Str("owner", owner).Msg("Failed to keep region polled")
+				continue
+			}
+			held[region] = sub
+			log.Info().Str("region", region).Str("owner", owner).Msg("Keeping region polled for background checks")
+		}
+	}
+
+	sync()
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sync()
+		}
+	}
+}
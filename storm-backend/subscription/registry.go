@@ -0,0 +1,319 @@
+package subscription
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// Управление опросом регионов в weather-worker
+type Poller interface {
+	StartPolling(region, userID string, generation int64) error
+	StopPolling(region string, generation int64) error
+}
+
+// Ключи Redis, общие для всех реплик storm-backend
+const (
+	regionsKey     = "storm:regions:subscribed" // Множество регионов, у которых есть или были подписчики
+	subscribersKey = "storm:subscribers:%s"     // ZSET активных подписчиков, score — окончание аренды (unix ms)
+	lingerKey      = "storm:linger:%s"          // ZSET ушедших подписчиков, score — окончание grace-периода (unix ms)
+	pollerKey      = "storm:poller:%s"          // Поколение запущенного опроса; наличие ключа означает, что опрос запрошен
+	generationKey  = "storm:poller-gen:%s"      // Монотонный счётчик поколений опроса
+)
+
+// Добавление подписчика; если опрос ещё не запрошен, назначается новое поколение
+var acquireScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', ARGV[3])
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[1])
+redis.call('SADD', KEYS[5], ARGV[4])
+if redis.call('EXISTS', KEYS[3]) == 1 then
+	return 0
+end
+local gen = redis.call('INCR', KEYS[4])
+redis.call('SET', KEYS[3], gen)
+return gen
+`)
+
+// Снятие опроса, если у региона не осталось ни активных, ни «задержавшихся» подписчиков
+var stopIfIdleScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', ARGV[1])
+if redis.call('ZCARD', KEYS[1]) > 0 or redis.call('ZCARD', KEYS[2]) > 0 then
+	return 0
+end
+redis.call('SREM', KEYS[5], ARGV[2])
+local gen = redis.call('GET', KEYS[3])
+if not gen then
+	return 0
+end
+redis.call('DEL', KEYS[3])
+return tonumber(gen)
+`)
+
+// Состояние подписок региона для отладки
+type RegionStats struct {
+	Region     string
+	Active     int64 // Подписчики с действующей арендой во всех репликах
+	Lingering  int64 // Ушедшие подписчики, ожидающие окончания grace-периода
+	Local      int   // Подписчики в этой реплике
+	Generation int64 // Поколение опроса, 0 — опрос не запрошен
+}
+
+// Реестр подписок на регионы с подсчётом ссылок через Redis
+type Registry struct {
+	rdb    *redis.Client
+	poller Poller
+	grace  time.Duration // Сколько ждать после ухода последнего подписчика
+	lease  time.Duration // Срок аренды подписчика (на случай падения реплики)
+	tick   time.Duration // Период продления аренды и проверки простаивающих регионов
+
+	replicaID string
+	mu        sync.Mutex
+	seq       uint64
+	local     map[string]map[string]struct{} // region -> идентификаторы подписчиков этой реплики
+}
+
+// Подписка одного потока на регион
+type Subscription struct {
+	registry *Registry
+	region   string
+	id       string
+	once     sync.Once
+}
+
+// Создание реестра; grace берётся из SUBSCRIPTION_GRACE (по умолчанию 30s)
+func NewRegistry(rdb *redis.Client, poller Poller) *Registry {
+	grace := 30 * time.Second
+	if raw := os.Getenv("SUBSCRIPTION_GRACE"); raw != "" {
+		if parsed, err := time.ParseDuration(raw); err == nil {
+			grace = parsed
+		} else {
+			log.Warn().Err(err).Str("value", raw).Msg("Invalid SUBSCRIPTION_GRACE, using default")
+		}
+	}
+
+	return &Registry{
+		rdb:       rdb,
+		poller:    poller,
+		grace:     grace,
+		lease:     60 * time.Second,
+		tick:      15 * time.Second,
+		replicaID: newReplicaID(),
+		local:     make(map[string]map[string]struct{}),
+	}
+}
+
+// Регистрация подписчика региона; первый подписчик запускает опрос
+func (r *Registry) Acquire(ctx context.Context, region, userID string) (*Subscription, error) {
+	r.mu.Lock()
+	r.seq++
+	id := fmt.Sprintf("%s:%d", r.replicaID, r.seq)
+	if r.local[region] == nil {
+		r.local[region] = make(map[string]struct{})
+	}
+	r.local[region][id] = struct{}{}
+	r.mu.Unlock()
+
+	sub := &Subscription{registry: r, region: region, id: id}
+	now := time.Now()
+	gen, err := acquireScript.Run(ctx, r.rdb, r.keys(region),
+		id, now.Add(r.lease).UnixMilli(), now.UnixMilli(), region).Int64()
+	if err != nil {
+		r.forgetLocal(region, id)
+		return nil, fmt.Errorf("failed to register subscriber for %s: %w", region, err)
+	}
+
+	if gen > 0 { // Это первый подписчик — запускаем единственный опрос региона
+		log.Info().Str("region", region).Int64("generation", gen).Msg("First subscriber, starting polling")
+		if err := r.poller.StartPolling(region, userID, gen); err != nil {
+			sub.Release()
+			return nil, err
+		}
+	}
+	return sub, nil
+}
+
+// Снятие подписки; опрос остановится после grace-периода, если никто не вернётся
+func (s *Subscription) Release() {
+	s.once.Do(func() {
+		r := s.registry
+		r.forgetLocal(s.region, s.id)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.ZRem(ctx, fmt.Sprintf(subscribersKey, s.region), s.id)
+			pipe.ZAdd(ctx, fmt.Sprintf(lingerKey, s.region), redis.Z{
+				Score:  float64(time.Now().Add(r.grace).UnixMilli()),
+				Member: s.id,
+			})
+			return nil
+		})
+		if err != nil {
+			log.Error().Err(err).Str("region", s.region).Msg("Failed to release subscriber")
+		}
+	})
+}
+
+// Фоновое продление аренды и остановка опроса простаивающих регионов
+func (r *Registry) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.refreshLeases(ctx)
+			r.reapIdle(ctx)
+		}
+	}
+}
+
+// Текущее состояние подписок по всем известным регионам
+func (r *Registry) Stats(ctx context.Context) ([]RegionStats, error) {
+	regions, err := r.rdb.SMembers(ctx, regionsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscribed regions: %w", err)
+	}
+	sort.Strings(regions)
+
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	stats := make([]RegionStats, 0, len(regions))
+	for _, region := range regions {
+		pipe := r.rdb.Pipeline()
+		active := pipe.ZCount(ctx, fmt.Sprintf(subscribersKey, region), "("+now, "+inf")
+		lingering := pipe.ZCount(ctx, fmt.Sprintf(lingerKey, region), "("+now, "+inf")
+		generation := pipe.Get(ctx, fmt.Sprintf(pollerKey, region))
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			return nil, fmt.Errorf("failed to read subscriptions for %s: %w", region, err)
+		}
+		gen, _ := generation.Int64() // Отсутствие ключа означает, что опрос не запрошен
+
+		r.mu.Lock()
+		local := len(r.local[region])
+		r.mu.Unlock()
+
+		stats = append(stats, RegionStats{
+			Region:     region,
+			Active:     active.Val(),
+			Lingering:  lingering.Val(),
+			Local:      local,
+			Generation: gen,
+		})
+	}
+	return stats, nil
+}
+
+// Продление аренды подписчиков этой реплики и повторная отправка задачи опроса.
+// Повтор нужен, чтобы после перезапуска воркера опрос поднялся снова
+func (r *Registry) refreshLeases(ctx context.Context) {
+	r.mu.Lock()
+	local := make(map[string][]string, len(r.local))
+	for region, ids := range r.local {
+		for id := range ids {
+			local[region] = append(local[region], id)
+		}
+	}
+	r.mu.Unlock()
+
+	now := time.Now()
+	expiry := float64(now.Add(r.lease).UnixMilli())
+	for region, ids := range local {
+		// Обычный ZADD: подписчики живы в этой реплике, даже если reapIdle
+		// успел удалить их после паузы (GC, недоступность Redis дольше аренды)
+		members := make([]redis.Z, 0, len(ids))
+		for _, id := range ids {
+			members = append(members, redis.Z{Score: expiry, Member: id})
+		}
+		if err := r.rdb.ZAdd(ctx, fmt.Sprintf(subscribersKey, region), members...).Err(); err != nil {
+			log.Error().Err(err).Str("region", region).Msg("Failed to refresh subscriber leases")
+			continue
+		}
+
+		gen, err := r.rdb.Get(ctx, fmt.Sprintf(pollerKey, region)).Int64()
+		if err == redis.Nil {
+			// Опрос сняли, пока подписчики были вычищены, — запрашиваем его заново
+			gen, err = acquireScript.Run(ctx, r.rdb, r.keys(region),
+				ids[0], expiry, now.UnixMilli(), region).Int64()
+			if err == nil && gen > 0 {
+				log.Info().Str("region", region).Int64("generation", gen).Msg("Polling was stopped under live subscribers, restarting")
+			}
+		}
+		if err != nil {
+			log.Error().Err(err).Str("region", region).Msg("Failed to read polling generation")
+			continue
+		}
+		if gen == 0 { // Опрос уже запросила другая реплика между GET и скриптом
+			gen, err = r.rdb.Get(ctx, fmt.Sprintf(pollerKey, region)).Int64()
+			if err != nil {
+				continue
+			}
+		}
+		if err := r.poller.StartPolling(region, "", gen); err != nil {
+			log.Error().Err(err).Str("region", region).Msg("Failed to re-send polling task")
+		}
+	}
+}
+
+// Остановка опроса регионов без подписчиков
+func (r *Registry) reapIdle(ctx context.Context) {
+	regions, err := r.rdb.SMembers(ctx, regionsKey).Result()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list subscribed regions")
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	for _, region := range regions {
+		gen, err := stopIfIdleScript.Run(ctx, r.rdb, r.keys(region), now, region).Int64()
+		if err != nil {
+			log.Error().Err(err).Str("region", region).Msg("Failed to check idle region")
+			continue
+		}
+		if gen == 0 {
+			continue
+		}
+		log.Info().Str("region", region).Int64("generation", gen).Msg("No subscribers left, stopping polling")
+		if err := r.poller.StopPolling(region, gen); err != nil {
+			log.Error().Err(err).Str("region", region).Msg("Failed to stop polling")
+		}
+	}
+}
+
+func (r *Registry) forgetLocal(region, id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.local[region], id)
+	if len(r.local[region]) == 0 {
+		delete(r.local, region)
+	}
+}
+
+func (r *Registry) keys(region string) []string {
+	return []string{
+		fmt.Sprintf(subscribersKey, region),
+		fmt.Sprintf(lingerKey, region),
+		fmt.Sprintf(pollerKey, region),
+		fmt.Sprintf(generationKey, region),
+		regionsKey,
+	}
+}
+
+// Уникальный идентификатор реплики для имён подписчиков
+func newReplicaID() string {
+	host, _ := os.Hostname()
+	buf := make([]byte, 4)
+	_, _ = rand.Read(buf)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(buf))
+}
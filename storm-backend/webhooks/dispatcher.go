@@ -0,0 +1,261 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/models"
+
+	"github.com/rs/zerolog/log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Заголовки запроса уведомления
+const (
+	HeaderEvent     = "X-Storm-Event"
+	HeaderDelivery  = "X-Storm-Delivery"  // id уведомления, одинаковый во всех попытках
+	HeaderTimestamp = "X-Storm-Timestamp" // Unix-время отправки в секундах, входит в подпись
+	HeaderSignature = "X-Storm-Signature" // sha256=<hex HMAC-SHA256 от "<timestamp>.<тело>">
+)
+
+// Доставка уведомлений из очереди по HTTP с повторами
+type Dispatcher struct {
+	conn   *amqp.Connection
+	store  database.Store
+	cfg    Config
+	client *http.Client
+}
+
+func NewDispatcher(conn *amqp.Connection, store database.Store, cfg Config) *Dispatcher {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivate {
+		dialer.Control = publicOnly
+	}
+	return &Dispatcher{
+		conn:  conn,
+		store: store,
+		cfg:   cfg,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+			// Без прокси из окружения: иначе проверка адреса применялась бы к прокси, а не к получателю
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: cfg.Timeout,
+				MaxIdleConnsPerHost: cfg.Workers,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			// Перенаправление считается неудачной доставкой: подписанное тело уходит только на зарегистрированный адрес
+			CheckRedirect: func(req *http.Request, via []*http.Request) error { return http.ErrUseLastResponse },
+		},
+	}
+}
+
+// Чтение очереди до отмены контекста. Задача подтверждается после доставки
+// или после того, как она переложена в очередь повтора или в DeadQueue
+func (d *Dispatcher) Run(ctx context.Context) error {
+	ch, err := d.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open webhook channel: %w", err)
+	}
+	defer ch.Close()
+
+	if err := declareQueues(ch, d.cfg); err != nil {
+		return err
+	}
+	if err := ch.Qos(d.cfg.Workers, 0, false); err != nil {
+		return fmt.Errorf("failed to set QoS: %w", err)
+	}
+	deliveries, err := ch.Consume(DeliveriesQueue, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("failed to register webhook consumer: %w", err)
+	}
+
+	log.Info().Int("workers", d.cfg.Workers).Int("max_attempts", d.cfg.MaxAttempts).Dur("retry_base", d.cfg.RetryBase).Msg("Webhook dispatcher started")
+	var wg sync.WaitGroup
+	closed := make(chan struct{})
+	var once sync.Once
+	for i := 0; i < d.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case msg, ok := <-deliveries:
+					if !ok {
+						once.Do(func() { close(closed) })
+						return
+					}
+					d.handle(ch, msg)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-closed:
+		if ctx.Err() == nil {
+			return fmt.Errorf("webhook deliveries channel closed unexpectedly")
+		}
+	default:
+	}
+	return nil
+}
+
+// Одна попытка доставки
+func (d *Dispatcher) handle(ch *amqp.Channel, msg amqp.Delivery) {
+	var job models.WebhookDelivery
+	if err := json.Unmarshal(msg.Body, &job); err != nil || job.WebhookID == 0 || len(job.Body) == 0 {
+		log.Error().Err(err).Msg("Dropping malformed webhook delivery")
+		_ = msg.Reject(false) // Уходит в DeadQueue
+		return
+	}
+	if job.Attempt < 1 {
+		job.Attempt = 1
+	}
+
+	// Запрос не привязан к контексту Run: начатая доставка завершается и при остановке сервера
+	ctx, cancel := context.WithTimeout(context.Background(), d.cfg.Timeout)
+	defer cancel()
+	hook, err := d.store.GetWebhook(ctx, job.WebhookID)
+	if errors.Is(err, database.ErrNotFound) {
+		log.Info().Int64("webhook", job.WebhookID).Str("delivery", job.ID).Msg("Webhook was deleted, dropping delivery")
+		_ = msg.Ack(false)
+		return
+	}
+	if err == nil {
+		err = d.send(ctx, hook, job)
+	}
+	if err == nil {
+		log.Info().Int64("webhook", job.WebhookID).Str("delivery", job.ID).Str("event", job.Event).Int("attempt", job.Attempt).Msg("Webhook delivered")
+		_ = msg.Ack(false)
+		return
+	}
+
+	if err := d.retry(ch, job, err); err != nil {
+		log.Error().Err(err).Int64("webhook", job.WebhookID).Str("delivery", job.ID).Msg("Failed to reschedule webhook delivery, requeueing")
+		_ = msg.Nack(false, true)
+		return
+	}
+	_ = msg.Ack(false)
+}
+
+// POST тела уведомления с подписью; успех — любой ответ 2xx
+func (d *Dispatcher) send(ctx context.Context, hook *models.Webhook, job models.WebhookDelivery) error {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(job.Body))
+	if err != nil {
+		return fmt.Errorf("invalid webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Storm-Hunt-Webhooks/1")
+	req.Header.Set(HeaderEvent, job.Event)
+	req.Header.Set(HeaderDelivery, job.ID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, "sha256="+Sign(hook.Secret, timestamp, job.Body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10)) // Позволяет переиспользовать соединение
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// Перекладывание неудачной задачи в очередь ожидания следующей попытки или, после последней, в DeadQueue
+func (d *Dispatcher) retry(ch *amqp.Channel, job models.WebhookDelivery, cause error) error {
+	logger := log.Warn().Err(cause).Int64("webhook", job.WebhookID).Str("delivery", job.ID).Str("event", job.Event).Int("attempt", job.Attempt)
+	headers := amqp.Table{"x-last-error": cause.Error()}
+
+	queue := DeadQueue
+	next, delay, dead := d.cfg.next(job.Attempt, cause)
+	if dead {
+		logger.Msg("Webhook delivery failed, moving to dead-letter queue")
+	} else {
+		job.Attempt = next
+		// Объявление идемпотентно; без него задача пропала бы, если очередь ещё не создана
+		name, err := declareRetryQueue(ch, delay)
+		if err != nil {
+			return err
+		}
+		queue = name
+		logger.Dur("retry_in", delay).Msg("Webhook delivery failed, scheduling retry")
+	}
+
+	body, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return ch.PublishWithContext(context.Background(), "", queue, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Headers:      headers,
+		Body:         body,
+	})
+}
+
+// Адрес получателя во внутренней сети
+var ErrPrivateAddress = errors.New("webhook address is not public")
+
+// Проверка адреса после разрешения имени, перед соединением: уведомления не уходят на loopback,
+// в частные и link-local сети (сервисы compose, метаданные облака), даже если имя указывает туда через DNS
+func publicOnly(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, address)
+	}
+	if ip := addrPort.Addr().Unmap(); privateIP(ip) {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, ip)
+	}
+	return nil
+}
+
+// Хост адреса уведомлений — localhost или IP внутренней сети. Имена, которые разрешаются
+// во внутренние адреса, отсекает только publicOnly при соединении
+func PrivateHost(host string) bool {
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return true
+	}
+	ip, err := netip.ParseAddr(strings.Trim(host, "[]"))
+	return err == nil && privateIP(ip.Unmap())
+}
+
+func privateIP(ip netip.Addr) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() || ip.IsInterfaceLocalMulticast() || sharedAddressSpace.Contains(ip)
+}
+
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10") // CGNAT (RFC 6598)
+
+// Подпись тела: hex HMAC-SHA256 с секретом адреса от "<timestamp>.<тело>".
+// Получатель пересчитывает её и отклоняет запросы со старым timestamp, чтобы перехваченный запрос нельзя было повторить
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,220 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"Storm-Hunt/storm-backend/models"
+)
+
+func TestSign(t *testing.T) {
+	// Эталон: echo -n '1700000000.{"event":"alert.firing"}' | openssl dgst -sha256 -hmac whsec-test
+	const want = "87d8b0599f794caba4ed75c3f758c0a5a9da7debcb65a7282e45c2f68843890b"
+	if got := Sign("whsec-test", "1700000000", []byte(`{"event":"alert.firing"}`)); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+	if Sign("other-secret", "1700000000", []byte(`{"event":"alert.firing"}`)) == want {
+		t.Error("signature does not depend on the secret")
+	}
+	if Sign("whsec-test", "1700000001", []byte(`{"event":"alert.firing"}`)) == want {
+		t.Error("signature does not depend on the timestamp")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := Config{MaxAttempts: 4, RetryBase: 10 * time.Second}
+	for attempt, want := range map[int]time.Duration{2: 10 * time.Second, 3: 20 * time.Second, 4: 40 * time.Second, 6: 160 * time.Second} {
+		if got := cfg.backoff(attempt); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+
+	tests := []struct {
+		attempt int
+		cause   error
+		next    int
+		delay   time.Duration
+		dead    bool
+	}{
+		{1, errors.New("503"), 2, 10 * time.Second, false},
+		{3, errors.New("timeout"), 4, 40 * time.Second, false},
+		{4, errors.New("503"), 4, 0, true},
+		{1, ErrPrivateAddress, 1, 0, true},
+	}
+	for _, tt := range tests {
+		next, delay, dead := cfg.next(tt.attempt, tt.cause)
+		if next != tt.next || delay != tt.delay || dead != tt.dead {
+			t.Errorf("next(%d, %v) = %d, %v, %v, want %d, %v, %v", tt.attempt, tt.cause, next, delay, dead, tt.next, tt.delay, tt.dead)
+		}
+	}
+}
+
+func TestSend(t *testing.T) {
+	hook := &models.Webhook{ID: 7, Secret: "whsec-test"}
+	job := models.WebhookDelivery{ID: "delivery-1", WebhookID: 7, Event: "storm.opened", Body: []byte(`{"id":"delivery-1"}`), Attempt: 1}
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		ok      bool
+	}{
+		{"200", func(w http.ResponseWriter, r *http.Request) {}, true},
+		{"204", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) }, true},
+		{"redirect", func(w http.ResponseWriter, r *http.Request) { http.Redirect(w, r, "/elsewhere", http.StatusFound) }, false},
+		{"404", http.NotFound, false},
+		{"500", func(w http.ResponseWriter, r *http.Request) { http.Error(w, "boom", http.StatusInternalServerError) }, false},
+		{"timeout", func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(2 * time.Second):
+			}
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				requests int
+				checked  error
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.URL.Path == "/hook" {
+					checked = checkRequest(r, hook.Secret, job)
+				}
+				tt.handler(w, r)
+			}))
+			defer server.Close()
+
+			// Заглушка на loopback, поэтому проверка адреса выключена
+			d := NewDispatcher(nil, nil, Config{Timeout: 500 * time.Millisecond, Workers: 1, AllowPrivate: true})
+			target := *hook
+			target.URL = server.URL + "/hook"
+			err := d.send(context.Background(), &target, job)
+			server.Close() // Дожидается обработчика, прежде чем читать requests и checked
+			if (err == nil) != tt.ok {
+				t.Fatalf("send = %v, want ok %v", err, tt.ok)
+			}
+			if checked != nil {
+				t.Error(checked)
+			}
+			if requests != 1 {
+				t.Errorf("got %d requests, want 1 (redirects must not be followed)", requests)
+			}
+		})
+	}
+}
+
+// Заголовки и подпись запроса, как их проверяет получатель
+func checkRequest(r *http.Request, secret string, job models.WebhookDelivery) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+		return errors.New("expected a JSON POST")
+	}
+	if r.Header.Get(HeaderEvent) != job.Event || r.Header.Get(HeaderDelivery) != job.ID {
+		return errors.New("event or delivery header mismatch")
+	}
+	timestamp := r.Header.Get(HeaderTimestamp)
+	sent, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Since(time.Unix(sent, 0)) > time.Minute {
+		return errors.New("timestamp is missing or stale")
+	}
+	if r.Header.Get(HeaderSignature) != "sha256="+Sign(secret, timestamp, body) {
+		return errors.New("signature mismatch")
+	}
+	if string(body) != string(job.Body) {
+		return errors.New("body mismatch")
+	}
+	return nil
+}
+
+func TestSendRefusesPrivateAddresses(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { requests++ }))
+	defer server.Close()
+
+	d := NewDispatcher(nil, nil, Config{Timeout: time.Second, Workers: 1})
+	hook := &models.Webhook{ID: 1, URL: server.URL, Secret: "s"}
+	err := d.send(context.Background(), hook, models.WebhookDelivery{ID: "d", WebhookID: 1, Event: "storm.opened", Body: []byte(`{}`)})
+	if !errors.Is(err, ErrPrivateAddress) {
+		t.Fatalf("send = %v, want ErrPrivateAddress", err)
+	}
+	if requests != 0 {
+		t.Errorf("loopback stub got %d requests", requests)
+	}
+}
+
+func TestPrivateHost(t *testing.T) {
+	tests := map[string]bool{
+		"localhost":            true,
+		"api.localhost":        true,
+		"127.0.0.1":            true,
+		"10.1.2.3":             true,
+		"172.18.0.5":           true,
+		"192.168.1.10":         true,
+		"169.254.169.254":      true,
+		"100.64.0.1":           true,
+		"0.0.0.0":              true,
+		"::1":                  true,
+		"[::1]":                true,
+		"fd00::1":              true,
+		"fe80::1":              true,
+		"::ffff:127.0.0.1":     true,
+		"8.8.8.8":              false,
+		"2001:4860:4860::8888": false,
+		"hooks.example.com":    false,
+		"redis":                false, // Имя проверяется после разрешения, в publicOnly
+	}
+	for host, want := range tests {
+		if got := PrivateHost(host); got != want {
+			t.Errorf("PrivateHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+func TestPublicOnly(t *testing.T) {
+	tests := map[string]bool{
+		"127.0.0.1:80":          false,
+		"10.0.0.1:443":          false,
+		"[::1]:8080":            false,
+		"[::ffff:10.0.0.1]:443": false,
+		"93.184.216.34:443":     true,
+		"[2606:2800::1]:443":    true,
+		"not-an-address":        false,
+	}
+	for address, public := range tests {
+		err := publicOnly("tcp", address, nil)
+		if (err == nil) != public {
+			t.Errorf("publicOnly(%q) = %v, want public %v", address, err, public)
+		}
+		if err != nil && !errors.Is(err, ErrPrivateAddress) {
+			t.Errorf("publicOnly(%q) = %v, want ErrPrivateAddress", address, err)
+		}
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("WEBHOOK_MAX_ATTEMPTS", "3")
+	t.Setenv("WEBHOOK_RETRY_BASE", "1s")
+	t.Setenv("WEBHOOK_ALLOW_PRIVATE", "true")
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.MaxAttempts != 3 || cfg.RetryBase != time.Second || cfg.Timeout != 10*time.Second || cfg.Workers != 4 || !cfg.AllowPrivate {
+		t.Errorf("ConfigFromEnv = %+v", cfg)
+	}
+
+	t.Setenv("WEBHOOK_ALLOW_PRIVATE", "sometimes")
+	if _, err := ConfigFromEnv(); err == nil {
+		t.Error("invalid WEBHOOK_ALLOW_PRIVATE accepted")
+	}
+}
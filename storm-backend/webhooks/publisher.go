@@ -0,0 +1,137 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/models"
+
+	"github.com/rs/zerolog/log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Постановка уведомлений в очередь доставки: одна задача на каждый подходящий адрес
+type Publisher struct {
+	store database.Store
+	ch    *amqp.Channel
+}
+
+func NewPublisher(conn *amqp.Connection, store database.Store, cfg Config) (*Publisher, error) {
+	ch, err := conn.Channel() // Отдельный канал: ошибка публикации не должна закрыть канал задач опроса
+	if err != nil {
+		return nil, fmt.Errorf("failed to open webhook channel: %w", err)
+	}
+	if err := declareQueues(ch, cfg); err != nil {
+		ch.Close()
+		return nil, err
+	}
+	return &Publisher{store: store, ch: ch}, nil
+}
+
+// Оповещение по правилу уходит на адреса владельца правила (реализация alerts.Notifier)
+func (p *Publisher) NotifyAlert(ctx context.Context, event models.AlertEvent) {
+	hooks, err := p.store.ListWebhooks(ctx, event.UserID)
+	if err != nil {
+		log.Error().Err(err).Int64("rule", event.RuleID).Msg("Failed to load webhooks for alert")
+		return
+	}
+	kind := models.WebhookAlertFiring
+	if event.State == models.AlertResolved {
+		kind = models.WebhookAlertResolved
+	}
+	var targets []models.Webhook
+	for _, hook := range hooks {
+		if hook.Alerts {
+			targets = append(targets, hook)
+		}
+	}
+	p.enqueue(ctx, targets, kind, event)
+}
+
+// Открытие и закрытие шторма уходят на адреса, подписанные на штормы региона (реализация storms.Notifier).
+// Обновления трека не отправляются: их слишком много для пейджера
+func (p *Publisher) NotifyStorm(ctx context.Context, event models.StormEvent) {
+	var kind string
+	switch event.Event {
+	case models.StormEventOpened:
+		kind = models.WebhookStormOpened
+	case models.StormEventClosed:
+		kind = models.WebhookStormClosed
+	default:
+		return
+	}
+	hooks, err := p.store.StormWebhooks(ctx, event.Storm.Region)
+	if err != nil {
+		log.Error().Err(err).Str("storm", event.Storm.ID).Msg("Failed to load webhooks for storm")
+		return
+	}
+	p.enqueue(ctx, hooks, kind, event)
+}
+
+// Вход и выход из зоны уходят на адреса владельца зоны (реализация zones.Notifier)
+func (p *Publisher) NotifyZone(ctx context.Context, event models.ZoneEvent) {
+	hooks, err := p.store.ListWebhooks(ctx, event.UserID)
+	if err != nil {
+		log.Error().Err(err).Int64("zone", event.ZoneID).Msg("Failed to load webhooks for zone")
+		return
+	}
+	kind := models.WebhookZoneEntered
+	if event.Event == models.ZoneExit {
+		kind = models.WebhookZoneExited
+	}
+	var targets []models.Webhook
+	for _, hook := range hooks {
+		if hook.Zones {
+			targets = append(targets, hook)
+		}
+	}
+	p.enqueue(ctx, targets, kind, event)
+}
+
+// Ошибки только логируются: событие уже сохранено, а уведомление — дополнительный канал
+func (p *Publisher) enqueue(ctx context.Context, hooks []models.Webhook, kind string, data interface{}) {
+	if len(hooks) == 0 {
+		return
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		log.Error().Err(err).Str("event", kind).Msg("Failed to encode webhook data")
+		return
+	}
+	for _, hook := range hooks {
+		id := newDeliveryID()
+		body, _ := json.Marshal(models.WebhookPayload{
+			ID:        id,
+			Event:     kind,
+			CreatedAt: time.Now().UTC(),
+			Data:      encoded,
+		})
+		job, _ := json.Marshal(models.WebhookDelivery{ID: id, WebhookID: hook.ID, Event: kind, Body: body, Attempt: 1})
+		err := p.ch.PublishWithContext(ctx, "", DeliveriesQueue, false, false, amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			Body:         job,
+		})
+		if err != nil {
+			log.Error().Err(err).Int64("webhook", hook.ID).Str("event", kind).Msg("Failed to enqueue webhook delivery")
+			continue
+		}
+		log.Debug().Int64("webhook", hook.ID).Str("event", kind).Msg("Webhook delivery enqueued")
+	}
+}
+
+func (p *Publisher) Close() error {
+	return p.ch.Close()
+}
+
+func newDeliveryID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,115 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Очереди доставки. Неудачная попытка уходит в очередь ожидания с TTL, откуда RabbitMQ
+// по истечении TTL возвращает её в DeliveriesQueue; после последней попытки задача попадает в DeadQueue
+const (
+	DeliveriesQueue = "webhook_deliveries"
+	DeadQueue       = "webhook_dead"
+	retryQueue      = "webhook_retry.%dms" // Очередь ожидания перед повтором; имя содержит задержку, чтобы смена настроек не конфликтовала с уже объявленными очередями
+)
+
+// Настройки доставки
+type Config struct {
+	MaxAttempts int           // Попыток доставки, включая первую
+	RetryBase   time.Duration // Задержка перед второй попыткой; каждая следующая вдвое дольше
+	Timeout     time.Duration // Таймаут одного HTTP-запроса
+	Workers     int           // Одновременных доставок в реплике
+
+	AllowPrivate bool // Разрешить адреса loopback, частных и link-local сетей (только для локальной разработки)
+}
+
+// Настройки из WEBHOOK_MAX_ATTEMPTS (6), WEBHOOK_RETRY_BASE (10s), WEBHOOK_TIMEOUT (10s), WEBHOOK_WORKERS (4)
+// и WEBHOOK_ALLOW_PRIVATE (false)
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{MaxAttempts: 6, RetryBase: 10 * time.Second, Timeout: 10 * time.Second, Workers: 4}
+	for name, target := range map[string]*int{
+		"WEBHOOK_MAX_ATTEMPTS": &cfg.MaxAttempts,
+		"WEBHOOK_WORKERS":      &cfg.Workers,
+	} {
+		if raw := os.Getenv(name); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 1 {
+				return cfg, fmt.Errorf("invalid %s %q", name, raw)
+			}
+			*target = n
+		}
+	}
+	for name, target := range map[string]*time.Duration{
+		"WEBHOOK_RETRY_BASE": &cfg.RetryBase,
+		"WEBHOOK_TIMEOUT":    &cfg.Timeout,
+	} {
+		if raw := os.Getenv(name); raw != "" {
+			d, err := time.ParseDuration(raw)
+			if err != nil || d <= 0 {
+				return cfg, fmt.Errorf("invalid %s %q", name, raw)
+			}
+			*target = d
+		}
+	}
+	if raw := os.Getenv("WEBHOOK_ALLOW_PRIVATE"); raw != "" {
+		allow, err := strconv.ParseBool(raw)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid WEBHOOK_ALLOW_PRIVATE %q", raw)
+		}
+		cfg.AllowPrivate = allow
+	}
+	return cfg, nil
+}
+
+// Задержка перед попыткой attempt (со второй): RetryBase, 2×RetryBase, 4×RetryBase...
+func (c Config) backoff(attempt int) time.Duration {
+	return c.RetryBase << (attempt - 2)
+}
+
+// Что делать после неудачной попытки attempt: номер и задержка следующей попытки или DeadQueue (dead),
+// если попытки кончились или повтор не поможет (адрес во внутренней сети)
+func (c Config) next(attempt int, cause error) (int, time.Duration, bool) {
+	if attempt >= c.MaxAttempts || errors.Is(cause, ErrPrivateAddress) {
+		return attempt, 0, true
+	}
+	return attempt + 1, c.backoff(attempt + 1), false
+}
+
+// Объявление очередей доставки. Отклонённые задачи основной очереди (например, испорченные) уходят в DeadQueue
+func declareQueues(ch *amqp.Channel, cfg Config) error {
+	if _, err := ch.QueueDeclare(DeadQueue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("failed to declare webhook dead-letter queue: %w", err)
+	}
+	_, err := ch.QueueDeclare(DeliveriesQueue, true, false, false, false, amqp.Table{
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": DeadQueue,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to declare webhook deliveries queue: %w", err)
+	}
+	for attempt := 2; attempt <= cfg.MaxAttempts; attempt++ {
+		if _, err := declareRetryQueue(ch, cfg.backoff(attempt)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Очередь ожидания с задержкой delay; возвращает её имя
+func declareRetryQueue(ch *amqp.Channel, delay time.Duration) (string, error) {
+	name := fmt.Sprintf(retryQueue, delay.Milliseconds())
+	_, err := ch.QueueDeclare(name, true, false, false, false, amqp.Table{
+		"x-message-ttl":             delay.Milliseconds(),
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": DeliveriesQueue,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to declare webhook retry queue %s: %w", name, err)
+	}
+	return name, nil
+}
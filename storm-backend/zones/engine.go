@@ -0,0 +1,367 @@
+package zones
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"Storm-Hunt/storm-backend/catalog"
+	"Storm-Hunt/storm-backend/database"
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/hub"
+	"Storm-Hunt/storm-backend/models"
+	"Storm-Hunt/storm-backend/subscription"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// Ключи Redis, общие для всех реплик: каждое обновление получают все реплики, а обрабатывает одна.
+// Источник — region:<регион> или storm:<id шторма>
+const (
+	stateKey = "zone:state:%s" // HASH: last — последнее обработанное обновление, zone:<id> — источник внутри зоны
+	lockKey  = "zone:lock:%s"  // Блокировка на время обработки обновления
+	lockTTL  = 10 * time.Second
+	stormTTL = 7 * 24 * time.Hour // Состояние шторма, который так и не закрылся
+
+	holdEvery = time.Minute // Как часто сверять регионы под зонами и удерживаемые подписки
+)
+
+// Каналы pub/sub событий зон: EventsPrefix + subject пользователя
+const EventsPrefix = "zone_events:"
+
+// Снятие блокировки, только если она всё ещё наша
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// Получатель событий зон помимо Redis, например очередь webhooks
+type Notifier interface {
+	NotifyZone(ctx context.Context, event models.ZoneEvent)
+}
+
+// Проверка зон наблюдения: положение штормов и точка самого сильного ветра регионов
+// сравниваются с зонами пользователей, переход через границу даёт событие enter или exit
+type Engine struct {
+	store  database.Store
+	rdb    *redis.Client
+	hub    *hub.Hub // Обновления weather-worker
+	storms *hub.Hub // События детектора штормов
+
+	Notifier Notifier // Необязательная внешняя доставка событий (webhooks)
+
+	// Если заданы оба, регионы, точки замера которых лежат в чьей-то зоне, опрашиваются и без клиентов
+	Subscriptions *subscription.Registry
+	Regions       *catalog.Catalog
+}
+
+func NewEngine(store database.Store, rdb *redis.Client, updates, storms *hub.Hub) *Engine {
+	return &Engine{store: store, rdb: rdb, hub: updates, storms: storms}
+}
+
+// Подписка на обновления и события штормов регионов до отмены контекста
+func (e *Engine) Run(ctx context.Context, regions []string) {
+	var wg sync.WaitGroup
+	consume := func(region string, sub *hub.Subscriber, handle func(payload string)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer sub.Close()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case payload, ok := <-sub.Updates():
+					if !ok {
+						return
+					}
+					handle(payload)
+				}
+			}
+		}()
+	}
+
+	for _, region := range regions {
+		region := region
+		updates, err := e.hub.Subscribe(ctx, region)
+		if err != nil {
+			log.Error().Err(err).Str("region", region).Msg("Zone engine failed to subscribe to region updates")
+			continue
+		}
+		consume(region, updates, func(payload string) {
+			var data models.CacheData
+			if err := json.Unmarshal([]byte(payload), &data); err != nil {
+				log.Error().Err(err).Str("region", region).Msg("Zone engine failed to decode weather update")
+				return
+			}
+			if err := e.ObserveWeather(ctx, region, data); err != nil {
+				log.Error().Err(err).Str("region", region).Msg("Failed to check watch zones for region update")
+			}
+		})
+
+		events, err := e.storms.Subscribe(ctx, region)
+		if err != nil {
+			log.Error().Err(err).Str("region", region).Msg("Zone engine failed to subscribe to storm events")
+			continue
+		}
+		consume(region, events, func(payload string) {
+			var event models.StormEvent
+			if err := json.Unmarshal([]byte(payload), &event); err != nil {
+				log.Error().Err(err).Str("region", region).Msg("Zone engine failed to decode storm event")
+				return
+			}
+			if err := e.ObserveStorm(ctx, event); err != nil {
+				log.Error().Err(err).Str("storm", event.Storm.ID).Msg("Failed to check watch zones for storm")
+			}
+		})
+	}
+	if e.Subscriptions != nil && e.Regions != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.Subscriptions.Hold(ctx, "system:zones", holdEvery, func(ctx context.Context) ([]string, error) {
+				return e.zoneRegions(ctx, regions)
+			})
+		}()
+	}
+	log.Info().Int("regions", len(regions)).Msg("Zone engine started")
+	wg.Wait()
+}
+
+// Регионы, у которых хотя бы одна точка замера лежит в зоне наблюдения. И точка самого
+// сильного ветра, и положение шторма — это точки замера региона, так что другие регионы
+// событий зон дать не могут
+func (e *Engine) zoneRegions(ctx context.Context, regions []string) ([]string, error) {
+	var needed []string
+	for _, id := range regions {
+		region, ok := e.Regions.Get(id)
+		if !ok {
+			continue
+		}
+		inside, err := e.anyPointInZone(ctx, region.Points)
+		if err != nil {
+			return nil, err
+		}
+		if inside {
+			needed = append(needed, id)
+		}
+	}
+	return needed, nil
+}
+
+func (e *Engine) anyPointInZone(ctx context.Context, points []catalog.Point) (bool, error) {
+	for _, point := range points {
+		p := geo.Point{Lat: float64(point.Lat), Lon: float64(point.Lon)}
+		candidates, err := e.store.WatchZonesAt(ctx, p.Lat, p.Lon)
+		if err != nil {
+			return false, err
+		}
+		for _, zone := range candidates {
+			if Contains(zone, p) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Точка самого сильного ветра в обновлении региона; она внутри зоны, если ветер не ниже порога зоны
+func (e *Engine) ObserveWeather(ctx context.Context, region string, data models.CacheData) error {
+	source := models.ZoneSourceRegion + ":" + region
+	unlock, err := e.lock(ctx, source)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	key := fmt.Sprintf(stateKey, source)
+	values, err := e.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("failed to load zone state for %s: %w", source, err)
+	}
+	at, err := time.Parse(time.RFC3339, data.Timestamp)
+	if err != nil {
+		at = time.Now()
+	}
+	at = at.UTC()
+	// Как и в правилах оповещений: повтор пропускается, а меньший номер с более поздним временем означает сброс счётчика воркера
+	last, _ := strconv.ParseUint(values["seq"], 10, 64)
+	lastAt, _ := time.Parse(time.RFC3339, values["last"])
+	if !at.After(lastAt) && (data.Sequence == 0 || data.Sequence <= last) {
+		return nil
+	}
+
+	pos := geo.Point{Lat: float64(data.Lat), Lon: float64(data.Lon)}
+	wind := float32(data.WindKmH)
+	candidates, err := e.store.WatchZonesAt(ctx, pos.Lat, pos.Lon)
+	if err != nil {
+		return err
+	}
+	inside := make(map[int64]models.WatchZone)
+	for _, zone := range candidates {
+		if wind >= zone.MinWindKmH && Contains(zone, pos) {
+			inside[zone.ID] = zone
+		}
+	}
+
+	template := models.ZoneEvent{Source: models.ZoneSourceRegion, Region: region, Lat: pos.Lat, Lon: pos.Lon, WindKmH: wind, OccurredAt: at}
+	return e.apply(ctx, key, values, inside, template, []interface{}{"seq", data.Sequence, "last", at.Format(time.RFC3339)})
+}
+
+// Положение шторма. Порог ветра зоны к штормам не применяется; закрытие шторма выводит его из всех зон
+func (e *Engine) ObserveStorm(ctx context.Context, event models.StormEvent) error {
+	storm := event.Storm
+	source := models.ZoneSourceStorm + ":" + storm.ID
+	unlock, err := e.lock(ctx, source)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	key := fmt.Sprintf(stateKey, source)
+	values, err := e.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("failed to load zone state for %s: %w", source, err)
+	}
+	updated := storm.UpdatedAt.UnixMilli()
+	if last, _ := strconv.ParseInt(values["last"], 10, 64); updated <= last && event.Event != models.StormEventClosed {
+		return nil
+	}
+
+	pos := geo.Point{Lat: float64(storm.Lat), Lon: float64(storm.Lon)}
+	inside := make(map[int64]models.WatchZone)
+	if event.Event != models.StormEventClosed {
+		candidates, err := e.store.WatchZonesAt(ctx, pos.Lat, pos.Lon)
+		if err != nil {
+			return err
+		}
+		for _, zone := range candidates {
+			if Contains(zone, pos) {
+				inside[zone.ID] = zone
+			}
+		}
+	}
+
+	template := models.ZoneEvent{
+		Source:     models.ZoneSourceStorm,
+		StormID:    storm.ID,
+		Region:     storm.Region,
+		Lat:        pos.Lat,
+		Lon:        pos.Lon,
+		WindKmH:    storm.WindKmH,
+		OccurredAt: storm.UpdatedAt.UTC(),
+	}
+	if err := e.apply(ctx, key, values, inside, template, []interface{}{"last", updated}); err != nil {
+		return err
+	}
+	if event.Event == models.StormEventClosed {
+		return e.rdb.Del(ctx, key).Err()
+	}
+	return e.rdb.Expire(ctx, key, stormTTL).Err()
+}
+
+// Сравнение зон, где источник теперь находится, с прежними; события на каждый переход границы
+func (e *Engine) apply(ctx context.Context, key string, values map[string]string, inside map[int64]models.WatchZone, template models.ZoneEvent, updates []interface{}) error {
+	for id, zone := range inside {
+		field := "zone:" + strconv.FormatInt(id, 10)
+		updates = append(updates, field, "1")
+		if values[field] == "" {
+			e.emit(ctx, zone, models.ZoneEnter, template)
+		}
+	}
+
+	var removed []string
+	for field := range values {
+		raw, ok := strings.CutPrefix(field, "zone:")
+		if !ok {
+			continue
+		}
+		id, _ := strconv.ParseInt(raw, 10, 64)
+		if _, still := inside[id]; still {
+			continue
+		}
+		zone, err := e.store.GetWatchZone(ctx, id)
+		if errors.Is(err, database.ErrNotFound) { // Зону удалили — выход не сообщается
+			removed = append(removed, field)
+			continue
+		}
+		if err != nil {
+			log.Error().Err(err).Int64("zone", id).Msg("Failed to read watch zone, keeping its state")
+			continue
+		}
+		e.emit(ctx, *zone, models.ZoneExit, template)
+		removed = append(removed, field)
+	}
+
+	pipe := e.rdb.TxPipeline()
+	pipe.HSet(ctx, key, updates...)
+	if len(removed) > 0 {
+		pipe.HDel(ctx, key, removed...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save zone state for %s: %w", key, err)
+	}
+	return nil
+}
+
+// Публикация события владельцу зоны. Ошибки только логируются, как и у оповещений
+func (e *Engine) emit(ctx context.Context, zone models.WatchZone, kind string, template models.ZoneEvent) {
+	event := template
+	event.ZoneID, event.UserID, event.ZoneName, event.Event = zone.ID, zone.UserID, zone.Name, kind
+	log.Info().Int64("zone", zone.ID).Str("user", zone.UserID).Str("event", kind).Str("source", event.Source).Str("storm", event.StormID).Str("region", event.Region).Msg("Watch zone crossed")
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Int64("zone", zone.ID).Msg("Failed to encode zone event")
+		return
+	}
+	if err := e.rdb.Publish(ctx, EventsPrefix+zone.UserID, payload).Err(); err != nil {
+		log.Error().Err(err).Int64("zone", zone.ID).Msg("Failed to publish zone event")
+	}
+	if e.Notifier != nil {
+		e.Notifier.NotifyZone(ctx, event)
+	}
+}
+
+// Блокировка источника, чтобы одно обновление не обрабатывалось параллельно в разных репликах
+func (e *Engine) lock(ctx context.Context, source string) (func(), error) {
+	key := fmt.Sprintf(lockKey, source)
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	token := hex.EncodeToString(buf)
+
+	deadline := time.Now().Add(lockTTL)
+	for {
+		ok, err := e.rdb.SetNX(ctx, key, token, lockTTL).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock watch zones for %s: %w", source, err)
+		}
+		if ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for zone lock of %s", source)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	return func() {
+		if err := unlockScript.Run(context.Background(), e.rdb, []string{key}, token).Err(); err != nil && err != redis.Nil {
+			log.Error().Err(err).Str("source", source).Msg("Failed to unlock watch zones")
+		}
+	}, nil
+}
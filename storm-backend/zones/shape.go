@@ -0,0 +1,63 @@
+package zones
+
+import (
+	"fmt"
+	"math"
+
+	"Storm-Hunt/storm-backend/geo"
+	"Storm-Hunt/storm-backend/models"
+)
+
+// Пределы формы зоны
+const (
+	MaxRadiusKm  = 2000
+	MaxVertices  = 100
+	maxLonSpread = 180 // Многоугольник через антимеридиан не поддерживается
+)
+
+// Проверка формы зоны и расчёт описанного прямоугольника
+func Prepare(zone *models.WatchZone) error {
+	switch zone.Shape {
+	case models.ZoneCircle:
+		if !validPoint(zone.Center) {
+			return fmt.Errorf("center must have lat in [-90, 90] and lon in [-180, 180]")
+		}
+		if zone.RadiusKm <= 0 || zone.RadiusKm > MaxRadiusKm {
+			return fmt.Errorf("radius_km must be in (0, %d]", MaxRadiusKm)
+		}
+		zone.Polygon = nil
+		zone.Bounds = geo.CircleBounds(zone.Center, zone.RadiusKm)
+	case models.ZonePolygon:
+		if len(zone.Polygon) < 3 || len(zone.Polygon) > MaxVertices {
+			return fmt.Errorf("polygon must have 3 to %d vertices", MaxVertices)
+		}
+		for _, p := range zone.Polygon {
+			if !validPoint(p) {
+				return fmt.Errorf("polygon vertices must have lat in [-90, 90] and lon in [-180, 180]")
+			}
+		}
+		zone.Center, zone.RadiusKm = geo.Point{}, 0
+		zone.Bounds = geo.PolygonBounds(zone.Polygon)
+		if zone.Bounds.MaxLon-zone.Bounds.MinLon > maxLonSpread {
+			return fmt.Errorf("polygon must not span more than %d degrees of longitude", maxLonSpread)
+		}
+	default:
+		return fmt.Errorf("unknown zone shape %q", zone.Shape)
+	}
+	if zone.MinWindKmH < 0 {
+		return fmt.Errorf("min_wind_kmh must not be negative")
+	}
+	return nil
+}
+
+// Попадание точки в зону
+func Contains(zone models.WatchZone, p geo.Point) bool {
+	if zone.Shape == models.ZoneCircle {
+		return geo.Distance(zone.Center, p) <= zone.RadiusKm
+	}
+	return geo.InPolygon(p, zone.Polygon)
+}
+
+func validPoint(p geo.Point) bool {
+	return !math.IsNaN(p.Lat) && !math.IsNaN(p.Lon) && p.Lat >= -90 && p.Lat <= 90 && p.Lon >= -180 && p.Lon <= 180
+}
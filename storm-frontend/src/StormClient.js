@@ -2,18 +2,21 @@ import { createGrpcWebTransport } from "@connectrpc/connect-web";
 import { createPromiseClient } from "@connectrpc/connect";
 import { StormService } from "./gen/storm_connect.js";
 
+const transportBaseUrl = "http://localhost:8080";
+
 const transport = createGrpcWebTransport({
-  baseUrl: "http://localhost:8080", 
+  baseUrl: transportBaseUrl, 
   useBinaryFormat: true,
   credentials: "include",
 });
 
 export const client = createPromiseClient(StormService, transport);
 
-export async function startStream(region, userId, token, onData, signal) {
+// resumeFrom — sequence последнего полученного обновления, чтобы после обрыва дослать пропущенное
+export async function startStream(region, userId, token, onData, signal, resumeFrom = 0) {
   const headers = { Authorization: `Bearer ${token}` };
   try {
-    const stream = client.startStream({ region, user_id: userId }, { headers, signal });
+    const stream = client.startStream({ region, user_id: userId, resumeFrom: BigInt(resumeFrom) }, { headers, signal });
     for await (const msg of stream) {
   console.log("🌪 Received update:", {
     region: msg.region,
@@ -33,4 +36,124 @@ export async function startStream(region, userId, token, onData, signal) {
     console.error("🔥 gRPC stream error:", err);
   }
  }
-}
\ No newline at end of file
+}
+export async function listRegions(token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  const response = await client.listRegions({}, { headers });
+  return response.regions;
+}
+export async function getObservations(region, { from, to, bucket, point, pageSize, pageToken } = {}, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  return client.getObservations(
+    { region, from, to, bucket, point, pageSize, pageToken },
+    { headers },
+  );
+}
+export async function listStorms({ status, region, from, to, minCategory, pageSize, source } = {}, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  const response = await client.listStorms(
+    { status, region, from, to, minCategory, pageSize, source },
+    { headers },
+  );
+  return response.storms;
+}
+// Карточка шторма; forecast — GeoJSON FeatureCollection с конусом прогноза у открытого шторма
+export async function getStorm(stormId, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  const storm = await client.getStorm({ stormId }, { headers });
+  return { ...storm, forecast: storm.forecast ? storm.forecast.toJson() : null };
+}
+// Трек шторма как обычный объект GeoJSON Feature (LineString, координаты [lon, lat])
+export async function getStormTrack(stormId, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  const response = await client.getStormTrack({ stormId }, { headers });
+  return response.feature ? response.feature.toJson() : null;
+}
+// Точка и время перехвата шторма из положения охотника { lat, lon }
+export async function planIntercept(stormId, position, speedKmh, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  return client.planIntercept({ stormId, position, speedKmh }, { headers });
+}
+// Правила оповещений текущего пользователя; metric и operator — значения AlertMetric и AlertOperator
+export async function createAlertRule(rule, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  return client.createAlertRule({ rule }, { headers });
+}
+export async function listAlertRules(token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  const response = await client.listAlertRules({}, { headers });
+  return response.rules;
+}
+export async function deleteAlertRule(id, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  await client.deleteAlertRule({ id: BigInt(id) }, { headers });
+}
+export async function listAlertEvents(pageSize, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  const response = await client.listAlertEvents({ pageSize }, { headers });
+  return response.events;
+}
+// Адреса уведомлений; secret подписи есть только в ответе createWebhook
+export async function createWebhook({ url, alerts, storms, zones, region } = {}, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  return client.createWebhook({ webhook: { url, alerts, storms, zones, region } }, { headers });
+}
+export async function listWebhooks(token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  const response = await client.listWebhooks({}, { headers });
+  return response.webhooks;
+}
+export async function deleteWebhook(id, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  await client.deleteWebhook({ id: BigInt(id) }, { headers });
+}
+// Зоны наблюдения: круг { name, center: { lat, lon }, radiusKm } или многоугольник { name, polygon: [{ lat, lon }, ...] }
+export async function createWatchZone(zone, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  return client.createWatchZone({ zone }, { headers });
+}
+export async function listWatchZones(token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  const response = await client.listWatchZones({}, { headers });
+  return response.zones;
+}
+export async function deleteWatchZone(id, token) {
+  const headers = { Authorization: `Bearer ${token}` };
+  await client.deleteWatchZone({ id: BigInt(id) }, { headers });
+}
+// Одно WebSocket-соединение для многих регионов: каналы weather:<регион>, storms:<регион>, alerts и zones.
+// Каждый кадр с данными подтверждается ack, иначе сервер перестанет слать новые
+export function connectUpdates(token, onFrame) {
+  const url = new URL("/v1/storm/ws", transportBaseUrl);
+  url.protocol = url.protocol === "https:" ? "wss:" : "ws:";
+  url.searchParams.set("access_token", token);
+  const socket = new WebSocket(url);
+  const channels = new Set();
+  const send = (msg) => socket.readyState === WebSocket.OPEN && socket.send(JSON.stringify(msg));
+
+  socket.onopen = () => channels.forEach((channel) => send({ type: "subscribe", channel }));
+  socket.onmessage = (event) => {
+    const frame = JSON.parse(event.data);
+    if (frame.seq) {
+      send({ type: "ack", seq: frame.seq });
+    }
+    onFrame(frame);
+  };
+  return {
+    subscribe(channel) {
+      channels.add(channel);
+      send({ type: "subscribe", channel });
+    },
+    unsubscribe(channel) {
+      channels.delete(channel);
+      send({ type: "unsubscribe", channel });
+    },
+    close: () => socket.close(),
+  };
+}
+// Ссылка на выгрузку файлом: path — "storms/<id>", "storms" или "observations", params — region, from, to, point;
+// токен в запросе, чтобы ссылку можно было открыть напрямую
+export function exportUrl(path, params, format, token) {
+  const query = new URLSearchParams({ ...params, format, access_token: token });
+  return `${transportBaseUrl}/v1/export/${path}?${query}`;
+}
@@ -19,6 +19,13 @@ const StormMap = ({ region, storm }) => {
           <strong>Temperature:</strong> {storm.temp.toFixed(1)} °C <br />
           <strong>Humidity:</strong> {storm.humidity} % <br />
           <strong>Wind Speed:</strong> {storm.wind_kmh} km/h <br />
+          <strong>Beaufort:</strong> {storm.beaufort ?? 0}
+          {storm.classification ? ` (${storm.classification})` : ""} <br />
+          {storm.category > 0 && (
+            <>
+              <strong>Saffir-Simpson:</strong> Category {storm.category} <br />
+            </>
+          )}
           <strong>Timestamp:</strong> {storm.timestamp || "N/A"} <br />
         </p>
       ) : (
@@ -1,5 +1,5 @@
 import React, { useEffect, useState, useRef } from "react";
-import { startStream, streamStormUpdates } from "./StormClient";
+import { startStream, listRegions } from "./StormClient";
 import { useKeycloak } from "@react-keycloak/web";
 import StormMap from "./StormMap";
 import "./StormTracker.css";
@@ -18,6 +18,7 @@ function decodeJwt(token) {
 const StormTracker = () => {
   const { keycloak, initialized } = useKeycloak();
   const [stormData, setStormData] = useState({});
+  const [regions, setRegions] = useState([]);
   const streamsRef = useRef({});
 
   // --- Универсальный запуск стрима ---
@@ -80,6 +81,9 @@ const StormTracker = () => {
             lon: response.lon ?? 0,
             wind_kmh: response.wind_kmh ?? response.windKmh ?? 0,
             timestamp: response.timestamp ?? "",
+            beaufort: response.beaufort ?? 0,
+            category: response.category ?? 0,
+            classification: response.classification ?? "",
           };
 
           setStormData((prev) => {
@@ -126,9 +130,10 @@ const StormTracker = () => {
         try {
           await keycloak.updateToken(30);
           if (keycloak.token) {
-            console.log("🔑 Token ready, starting storm streams...");
-            startStreamForRegion("Atlantic");
-            startStreamForRegion("Pacific");
+            console.log("🔑 Token ready, loading regions...");
+            const catalogue = await listRegions(keycloak.token);
+            setRegions(catalogue);
+            catalogue.forEach((region) => startStreamForRegion(region.id));
           } else {
             console.error("Failed to refresh token — redirecting to login");
             keycloak.login();
@@ -155,36 +160,28 @@ const StormTracker = () => {
       <h1 className="tracker-title">Storm Tracker 🌪️</h1>
 
       <div className="tracker-controls">
-        <button
-          className="tracker-btn start"
-          onClick={() => startStreamForRegion("Atlantic")}
-        >
-          Start Atlantic
-        </button>
-        <button
-          className="tracker-btn stop"
-          onClick={() => stopStream("Atlantic")}
-        >
-          Stop Atlantic
-        </button>
-
-        <button
-          className="tracker-btn start"
-          onClick={() => startStreamForRegion("Pacific")}
-        >
-          Start Pacific
-        </button>
-        <button
-          className="tracker-btn stop"
-          onClick={() => stopStream("Pacific")}
-        >
-          Stop Pacific
-        </button>
+        {regions.map((region) => (
+          <React.Fragment key={region.id}>
+            <button
+              className="tracker-btn start"
+              onClick={() => startStreamForRegion(region.id)}
+            >
+              Start {region.name}
+            </button>
+            <button
+              className="tracker-btn stop"
+              onClick={() => stopStream(region.id)}
+            >
+              Stop {region.name}
+            </button>
+          </React.Fragment>
+        ))}
       </div>
 
       <div style={{ display: "flex", flexDirection: "row" }}>
-        <StormMap region="Atlantic" storm={stormData["Atlantic"]} />
-        <StormMap region="Pacific" storm={stormData["Pacific"]} />
+        {regions.map((region) => (
+          <StormMap key={region.id} region={region.name} storm={stormData[region.id]} />
+        ))}
       </div>
     </div>
   );
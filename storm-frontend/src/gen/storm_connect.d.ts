@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { StartStreamRequest, WeatherData } from "./storm_pb.js";
+import { StartStreamRequest, WeatherData, StopStreamRequest, StopStreamResponse, ListRegionsRequest, ListRegionsResponse, GetObservationsRequest, GetObservationsResponse, ListStormsRequest, ListStormsResponse, GetStormRequest, Storm, GetStormTrackRequest, GetStormTrackResponse, PlanInterceptRequest, PlanInterceptResponse, CreateAlertRuleRequest, AlertRule, ListAlertRulesRequest, ListAlertRulesResponse, DeleteAlertRuleRequest, DeleteAlertRuleResponse, ListAlertEventsRequest, ListAlertEventsResponse, CreateWebhookRequest, Webhook, ListWebhooksRequest, ListWebhooksResponse, DeleteWebhookRequest, DeleteWebhookResponse, CreateWatchZoneRequest, WatchZone, ListWatchZonesRequest, ListWatchZonesResponse, DeleteWatchZoneRequest, DeleteWatchZoneResponse, ListSubscriptionsRequest, ListSubscriptionsResponse } from "./storm_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -21,6 +21,180 @@ export declare const StormService: {
       readonly O: typeof WeatherData,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * @generated from rpc stormhunter.StormService.StopStream
+     */
+    readonly stopStream: {
+      readonly name: "StopStream",
+      readonly I: typeof StopStreamRequest,
+      readonly O: typeof StopStreamResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListRegions
+     */
+    readonly listRegions: {
+      readonly name: "ListRegions",
+      readonly I: typeof ListRegionsRequest,
+      readonly O: typeof ListRegionsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.GetObservations
+     */
+    readonly getObservations: {
+      readonly name: "GetObservations",
+      readonly I: typeof GetObservationsRequest,
+      readonly O: typeof GetObservationsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListStorms
+     */
+    readonly listStorms: {
+      readonly name: "ListStorms",
+      readonly I: typeof ListStormsRequest,
+      readonly O: typeof ListStormsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Карточка шторма; у открытого шторма с прогнозом движения
+     *
+     * @generated from rpc stormhunter.StormService.GetStorm
+     */
+    readonly getStorm: {
+      readonly name: "GetStorm",
+      readonly I: typeof GetStormRequest,
+      readonly O: typeof Storm,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Трек шторма как GeoJSON Feature; REST отдаёт сам Feature
+     *
+     * @generated from rpc stormhunter.StormService.GetStormTrack
+     */
+    readonly getStormTrack: {
+      readonly name: "GetStormTrack",
+      readonly I: typeof GetStormTrackRequest,
+      readonly O: typeof GetStormTrackResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Расстояние, азимут и точка встречи охотника с открытым штормом
+     *
+     * @generated from rpc stormhunter.StormService.PlanIntercept
+     */
+    readonly planIntercept: {
+      readonly name: "PlanIntercept",
+      readonly I: typeof PlanInterceptRequest,
+      readonly O: typeof PlanInterceptResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Правила оповещений текущего пользователя
+     *
+     * @generated from rpc stormhunter.StormService.CreateAlertRule
+     */
+    readonly createAlertRule: {
+      readonly name: "CreateAlertRule",
+      readonly I: typeof CreateAlertRuleRequest,
+      readonly O: typeof AlertRule,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListAlertRules
+     */
+    readonly listAlertRules: {
+      readonly name: "ListAlertRules",
+      readonly I: typeof ListAlertRulesRequest,
+      readonly O: typeof ListAlertRulesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.DeleteAlertRule
+     */
+    readonly deleteAlertRule: {
+      readonly name: "DeleteAlertRule",
+      readonly I: typeof DeleteAlertRuleRequest,
+      readonly O: typeof DeleteAlertRuleResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Журнал срабатываний и снятий оповещений текущего пользователя
+     *
+     * @generated from rpc stormhunter.StormService.ListAlertEvents
+     */
+    readonly listAlertEvents: {
+      readonly name: "ListAlertEvents",
+      readonly I: typeof ListAlertEventsRequest,
+      readonly O: typeof ListAlertEventsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Адреса исходящих уведомлений текущего пользователя
+     *
+     * @generated from rpc stormhunter.StormService.CreateWebhook
+     */
+    readonly createWebhook: {
+      readonly name: "CreateWebhook",
+      readonly I: typeof CreateWebhookRequest,
+      readonly O: typeof Webhook,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListWebhooks
+     */
+    readonly listWebhooks: {
+      readonly name: "ListWebhooks",
+      readonly I: typeof ListWebhooksRequest,
+      readonly O: typeof ListWebhooksResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.DeleteWebhook
+     */
+    readonly deleteWebhook: {
+      readonly name: "DeleteWebhook",
+      readonly I: typeof DeleteWebhookRequest,
+      readonly O: typeof DeleteWebhookResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.CreateWatchZone
+     */
+    readonly createWatchZone: {
+      readonly name: "CreateWatchZone",
+      readonly I: typeof CreateWatchZoneRequest,
+      readonly O: typeof WatchZone,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListWatchZones
+     */
+    readonly listWatchZones: {
+      readonly name: "ListWatchZones",
+      readonly I: typeof ListWatchZonesRequest,
+      readonly O: typeof ListWatchZonesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.DeleteWatchZone
+     */
+    readonly deleteWatchZone: {
+      readonly name: "DeleteWatchZone",
+      readonly I: typeof DeleteWatchZoneRequest,
+      readonly O: typeof DeleteWatchZoneResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListSubscriptions
+     */
+    readonly listSubscriptions: {
+      readonly name: "ListSubscriptions",
+      readonly I: typeof ListSubscriptionsRequest,
+      readonly O: typeof ListSubscriptionsResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
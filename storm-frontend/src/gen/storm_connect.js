@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { StartStreamRequest, WeatherData } from "./storm_pb.js";
+import { StartStreamRequest, WeatherData, StopStreamRequest, StopStreamResponse, ListRegionsRequest, ListRegionsResponse, GetObservationsRequest, GetObservationsResponse, ListStormsRequest, ListStormsResponse, GetStormRequest, Storm, GetStormTrackRequest, GetStormTrackResponse, PlanInterceptRequest, PlanInterceptResponse, CreateAlertRuleRequest, AlertRule, ListAlertRulesRequest, ListAlertRulesResponse, DeleteAlertRuleRequest, DeleteAlertRuleResponse, ListAlertEventsRequest, ListAlertEventsResponse, CreateWebhookRequest, Webhook, ListWebhooksRequest, ListWebhooksResponse, DeleteWebhookRequest, DeleteWebhookResponse, CreateWatchZoneRequest, WatchZone, ListWatchZonesRequest, ListWatchZonesResponse, DeleteWatchZoneRequest, DeleteWatchZoneResponse, ListSubscriptionsRequest, ListSubscriptionsResponse } from "./storm_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -21,6 +21,180 @@ export const StormService = {
       O: WeatherData,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * @generated from rpc stormhunter.StormService.StopStream
+     */
+    stopStream: {
+      name: "StopStream",
+      I: StopStreamRequest,
+      O: StopStreamResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListRegions
+     */
+    listRegions: {
+      name: "ListRegions",
+      I: ListRegionsRequest,
+      O: ListRegionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.GetObservations
+     */
+    getObservations: {
+      name: "GetObservations",
+      I: GetObservationsRequest,
+      O: GetObservationsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListStorms
+     */
+    listStorms: {
+      name: "ListStorms",
+      I: ListStormsRequest,
+      O: ListStormsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Карточка шторма; у открытого шторма с прогнозом движения
+     *
+     * @generated from rpc stormhunter.StormService.GetStorm
+     */
+    getStorm: {
+      name: "GetStorm",
+      I: GetStormRequest,
+      O: Storm,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Трек шторма как GeoJSON Feature; REST отдаёт сам Feature
+     *
+     * @generated from rpc stormhunter.StormService.GetStormTrack
+     */
+    getStormTrack: {
+      name: "GetStormTrack",
+      I: GetStormTrackRequest,
+      O: GetStormTrackResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Расстояние, азимут и точка встречи охотника с открытым штормом
+     *
+     * @generated from rpc stormhunter.StormService.PlanIntercept
+     */
+    planIntercept: {
+      name: "PlanIntercept",
+      I: PlanInterceptRequest,
+      O: PlanInterceptResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Правила оповещений текущего пользователя
+     *
+     * @generated from rpc stormhunter.StormService.CreateAlertRule
+     */
+    createAlertRule: {
+      name: "CreateAlertRule",
+      I: CreateAlertRuleRequest,
+      O: AlertRule,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListAlertRules
+     */
+    listAlertRules: {
+      name: "ListAlertRules",
+      I: ListAlertRulesRequest,
+      O: ListAlertRulesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.DeleteAlertRule
+     */
+    deleteAlertRule: {
+      name: "DeleteAlertRule",
+      I: DeleteAlertRuleRequest,
+      O: DeleteAlertRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Журнал срабатываний и снятий оповещений текущего пользователя
+     *
+     * @generated from rpc stormhunter.StormService.ListAlertEvents
+     */
+    listAlertEvents: {
+      name: "ListAlertEvents",
+      I: ListAlertEventsRequest,
+      O: ListAlertEventsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Адреса исходящих уведомлений текущего пользователя
+     *
+     * @generated from rpc stormhunter.StormService.CreateWebhook
+     */
+    createWebhook: {
+      name: "CreateWebhook",
+      I: CreateWebhookRequest,
+      O: Webhook,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListWebhooks
+     */
+    listWebhooks: {
+      name: "ListWebhooks",
+      I: ListWebhooksRequest,
+      O: ListWebhooksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.DeleteWebhook
+     */
+    deleteWebhook: {
+      name: "DeleteWebhook",
+      I: DeleteWebhookRequest,
+      O: DeleteWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.CreateWatchZone
+     */
+    createWatchZone: {
+      name: "CreateWatchZone",
+      I: CreateWatchZoneRequest,
+      O: WatchZone,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListWatchZones
+     */
+    listWatchZones: {
+      name: "ListWatchZones",
+      I: ListWatchZonesRequest,
+      O: ListWatchZonesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.DeleteWatchZone
+     */
+    deleteWatchZone: {
+      name: "DeleteWatchZone",
+      I: DeleteWatchZoneRequest,
+      O: DeleteWatchZoneResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc stormhunter.StormService.ListSubscriptions
+     */
+    listSubscriptions: {
+      name: "ListSubscriptions",
+      I: ListSubscriptionsRequest,
+      O: ListSubscriptionsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -4,7 +4,178 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, Struct } from "@bufbuild/protobuf";
+
+/**
+ * Степень опасности ветра по шкале Бофорта и категории урагана (пороги настраиваются)
+ *
+ * @generated from enum stormhunter.Severity
+ */
+export declare enum Severity {
+  /**
+   * @generated from enum value: SEVERITY_NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * @generated from enum value: SEVERITY_LOW = 1;
+   */
+  LOW = 1,
+
+  /**
+   * @generated from enum value: SEVERITY_MODERATE = 2;
+   */
+  MODERATE = 2,
+
+  /**
+   * @generated from enum value: SEVERITY_HIGH = 3;
+   */
+  HIGH = 3,
+
+  /**
+   * @generated from enum value: SEVERITY_EXTREME = 4;
+   */
+  EXTREME = 4,
+}
+
+/**
+ * Размер интервала агрегации замеров
+ *
+ * @generated from enum stormhunter.Bucket
+ */
+export declare enum Bucket {
+  /**
+   * @generated from enum value: BUCKET_RAW = 0;
+   */
+  RAW = 0,
+
+  /**
+   * @generated from enum value: BUCKET_1M = 1;
+   */
+  1M = 1,
+
+  /**
+   * @generated from enum value: BUCKET_10M = 2;
+   */
+  10M = 2,
+
+  /**
+   * @generated from enum value: BUCKET_1H = 3;
+   */
+  1H = 3,
+}
+
+/**
+ * @generated from enum stormhunter.StormStatus
+ */
+export declare enum StormStatus {
+  /**
+   * @generated from enum value: STORM_STATUS_ANY = 0;
+   */
+  ANY = 0,
+
+  /**
+   * @generated from enum value: STORM_STATUS_ACTIVE = 1;
+   */
+  ACTIVE = 1,
+
+  /**
+   * @generated from enum value: STORM_STATUS_CLOSED = 2;
+   */
+  CLOSED = 2,
+}
+
+/**
+ * Показатель обновления региона, по которому срабатывает правило
+ *
+ * @generated from enum stormhunter.AlertMetric
+ */
+export declare enum AlertMetric {
+  /**
+   * @generated from enum value: ALERT_METRIC_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ALERT_METRIC_WIND_KMH = 1;
+   */
+  WIND_KMH = 1,
+
+  /**
+   * @generated from enum value: ALERT_METRIC_TEMP = 2;
+   */
+  TEMP = 2,
+
+  /**
+   * @generated from enum value: ALERT_METRIC_HUMIDITY = 3;
+   */
+  HUMIDITY = 3,
+
+  /**
+   * @generated from enum value: ALERT_METRIC_PRESSURE = 4;
+   */
+  PRESSURE = 4,
+}
+
+/**
+ * @generated from enum stormhunter.AlertOperator
+ */
+export declare enum AlertOperator {
+  /**
+   * @generated from enum value: ALERT_OPERATOR_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ALERT_OPERATOR_GT = 1;
+   */
+  GT = 1,
+
+  /**
+   * @generated from enum value: ALERT_OPERATOR_GTE = 2;
+   */
+  GTE = 2,
+
+  /**
+   * @generated from enum value: ALERT_OPERATOR_LT = 3;
+   */
+  LT = 3,
+
+  /**
+   * @generated from enum value: ALERT_OPERATOR_LTE = 4;
+   */
+  LTE = 4,
+
+  /**
+   * @generated from enum value: ALERT_OPERATOR_RISE = 5;
+   */
+  RISE = 5,
+
+  /**
+   * @generated from enum value: ALERT_OPERATOR_FALL = 6;
+   */
+  FALL = 6,
+}
+
+/**
+ * @generated from enum stormhunter.AlertState
+ */
+export declare enum AlertState {
+  /**
+   * @generated from enum value: ALERT_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ALERT_STATE_FIRING = 1;
+   */
+  FIRING = 1,
+
+  /**
+   * @generated from enum value: ALERT_STATE_RESOLVED = 2;
+   */
+  RESOLVED = 2,
+}
 
 /**
  * @generated from message stormhunter.StartStreamRequest
@@ -16,10 +187,20 @@ export declare class StartStreamRequest extends Message<StartStreamRequest> {
   region: string;
 
   /**
+   * Устарело: пользователь определяется по проверенному токену, значение игнорируется
+   *
    * @generated from field: string user_id = 2;
    */
   userId: string;
 
+  /**
+   * sequence последнего полученного обновления: пропущенные после него обновления
+   * досылаются из истории до перехода к живым. 0 — начать с последнего замера
+   *
+   * @generated from field: uint64 resume_from = 3;
+   */
+  resumeFrom: bigint;
+
   constructor(data?: PartialMessage<StartStreamRequest>);
 
   static readonly runtime: typeof proto3;
@@ -35,6 +216,154 @@ export declare class StartStreamRequest extends Message<StartStreamRequest> {
   static equals(a: StartStreamRequest | PlainMessage<StartStreamRequest> | undefined, b: StartStreamRequest | PlainMessage<StartStreamRequest> | undefined): boolean;
 }
 
+/**
+ * @generated from message stormhunter.StopStreamRequest
+ */
+export declare class StopStreamRequest extends Message<StopStreamRequest> {
+  /**
+   * @generated from field: string region = 1;
+   */
+  region: string;
+
+  constructor(data?: PartialMessage<StopStreamRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.StopStreamRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StopStreamRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StopStreamRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StopStreamRequest;
+
+  static equals(a: StopStreamRequest | PlainMessage<StopStreamRequest> | undefined, b: StopStreamRequest | PlainMessage<StopStreamRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.StopStreamResponse
+ */
+export declare class StopStreamResponse extends Message<StopStreamResponse> {
+  /**
+   * @generated from field: string region = 1;
+   */
+  region: string;
+
+  /**
+   * @generated from field: int32 stopped_streams = 2;
+   */
+  stoppedStreams: number;
+
+  /**
+   * В этой реплике не осталось потоков региона. Это не значит, что опрос остановлен: его держат подписчики
+   * других реплик, а без них он остановится только после grace-периода реестра подписок
+   *
+   * @generated from field: bool no_local_streams = 3;
+   */
+  noLocalStreams: boolean;
+
+  constructor(data?: PartialMessage<StopStreamResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.StopStreamResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StopStreamResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StopStreamResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StopStreamResponse;
+
+  static equals(a: StopStreamResponse | PlainMessage<StopStreamResponse> | undefined, b: StopStreamResponse | PlainMessage<StopStreamResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListSubscriptionsRequest
+ */
+export declare class ListSubscriptionsRequest extends Message<ListSubscriptionsRequest> {
+  constructor(data?: PartialMessage<ListSubscriptionsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListSubscriptionsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSubscriptionsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSubscriptionsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSubscriptionsRequest;
+
+  static equals(a: ListSubscriptionsRequest | PlainMessage<ListSubscriptionsRequest> | undefined, b: ListSubscriptionsRequest | PlainMessage<ListSubscriptionsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.RegionSubscriptions
+ */
+export declare class RegionSubscriptions extends Message<RegionSubscriptions> {
+  /**
+   * @generated from field: string region = 1;
+   */
+  region: string;
+
+  /**
+   * @generated from field: int64 subscribers = 2;
+   */
+  subscribers: bigint;
+
+  /**
+   * @generated from field: int64 lingering = 3;
+   */
+  lingering: bigint;
+
+  /**
+   * @generated from field: int32 local_subscribers = 4;
+   */
+  localSubscribers: number;
+
+  /**
+   * @generated from field: int64 generation = 5;
+   */
+  generation: bigint;
+
+  constructor(data?: PartialMessage<RegionSubscriptions>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.RegionSubscriptions";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegionSubscriptions;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegionSubscriptions;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegionSubscriptions;
+
+  static equals(a: RegionSubscriptions | PlainMessage<RegionSubscriptions> | undefined, b: RegionSubscriptions | PlainMessage<RegionSubscriptions> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListSubscriptionsResponse
+ */
+export declare class ListSubscriptionsResponse extends Message<ListSubscriptionsResponse> {
+  /**
+   * @generated from field: repeated stormhunter.RegionSubscriptions regions = 1;
+   */
+  regions: RegionSubscriptions[];
+
+  constructor(data?: PartialMessage<ListSubscriptionsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListSubscriptionsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSubscriptionsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSubscriptionsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSubscriptionsResponse;
+
+  static equals(a: ListSubscriptionsResponse | PlainMessage<ListSubscriptionsResponse> | undefined, b: ListSubscriptionsResponse | PlainMessage<ListSubscriptionsResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message stormhunter.WeatherData
  */
@@ -74,6 +403,41 @@ export declare class WeatherData extends Message<WeatherData> {
    */
   timestamp: string;
 
+  /**
+   * @generated from field: bool heartbeat = 8;
+   */
+  heartbeat: boolean;
+
+  /**
+   * @generated from field: string point = 9;
+   */
+  point: string;
+
+  /**
+   * @generated from field: int32 beaufort = 10;
+   */
+  beaufort: number;
+
+  /**
+   * @generated from field: int32 category = 11;
+   */
+  category: number;
+
+  /**
+   * @generated from field: stormhunter.Severity severity = 12;
+   */
+  severity: Severity;
+
+  /**
+   * @generated from field: string classification = 13;
+   */
+  classification: string;
+
+  /**
+   * @generated from field: uint64 sequence = 14;
+   */
+  sequence: bigint;
+
   constructor(data?: PartialMessage<WeatherData>);
 
   static readonly runtime: typeof proto3;
@@ -89,3 +453,1452 @@ export declare class WeatherData extends Message<WeatherData> {
   static equals(a: WeatherData | PlainMessage<WeatherData> | undefined, b: WeatherData | PlainMessage<WeatherData> | undefined): boolean;
 }
 
+/**
+ * @generated from message stormhunter.ListRegionsRequest
+ */
+export declare class ListRegionsRequest extends Message<ListRegionsRequest> {
+  constructor(data?: PartialMessage<ListRegionsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListRegionsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRegionsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRegionsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRegionsRequest;
+
+  static equals(a: ListRegionsRequest | PlainMessage<ListRegionsRequest> | undefined, b: ListRegionsRequest | PlainMessage<ListRegionsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.SamplePoint
+ */
+export declare class SamplePoint extends Message<SamplePoint> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: float lat = 2;
+   */
+  lat: number;
+
+  /**
+   * @generated from field: float lon = 3;
+   */
+  lon: number;
+
+  constructor(data?: PartialMessage<SamplePoint>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.SamplePoint";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SamplePoint;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SamplePoint;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SamplePoint;
+
+  static equals(a: SamplePoint | PlainMessage<SamplePoint> | undefined, b: SamplePoint | PlainMessage<SamplePoint> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.Region
+ */
+export declare class Region extends Message<Region> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string provider = 3;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: int32 interval_seconds = 4;
+   */
+  intervalSeconds: number;
+
+  /**
+   * @generated from field: repeated stormhunter.SamplePoint points = 5;
+   */
+  points: SamplePoint[];
+
+  constructor(data?: PartialMessage<Region>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.Region";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Region;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Region;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Region;
+
+  static equals(a: Region | PlainMessage<Region> | undefined, b: Region | PlainMessage<Region> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListRegionsResponse
+ */
+export declare class ListRegionsResponse extends Message<ListRegionsResponse> {
+  /**
+   * @generated from field: repeated stormhunter.Region regions = 1;
+   */
+  regions: Region[];
+
+  constructor(data?: PartialMessage<ListRegionsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListRegionsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRegionsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRegionsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRegionsResponse;
+
+  static equals(a: ListRegionsResponse | PlainMessage<ListRegionsResponse> | undefined, b: ListRegionsResponse | PlainMessage<ListRegionsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.GetObservationsRequest
+ */
+export declare class GetObservationsRequest extends Message<GetObservationsRequest> {
+  /**
+   * @generated from field: string region = 1;
+   */
+  region: string;
+
+  /**
+   * @generated from field: string from = 2;
+   */
+  from: string;
+
+  /**
+   * @generated from field: string to = 3;
+   */
+  to: string;
+
+  /**
+   * @generated from field: stormhunter.Bucket bucket = 4;
+   */
+  bucket: Bucket;
+
+  /**
+   * @generated from field: string point = 5;
+   */
+  point: string;
+
+  /**
+   * @generated from field: int32 page_size = 6;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 7;
+   */
+  pageToken: string;
+
+  constructor(data?: PartialMessage<GetObservationsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.GetObservationsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetObservationsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetObservationsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetObservationsRequest;
+
+  static equals(a: GetObservationsRequest | PlainMessage<GetObservationsRequest> | undefined, b: GetObservationsRequest | PlainMessage<GetObservationsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.MetricStats
+ */
+export declare class MetricStats extends Message<MetricStats> {
+  /**
+   * @generated from field: double min = 1;
+   */
+  min: number;
+
+  /**
+   * @generated from field: double max = 2;
+   */
+  max: number;
+
+  /**
+   * @generated from field: double avg = 3;
+   */
+  avg: number;
+
+  constructor(data?: PartialMessage<MetricStats>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.MetricStats";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricStats;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricStats;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricStats;
+
+  static equals(a: MetricStats | PlainMessage<MetricStats> | undefined, b: MetricStats | PlainMessage<MetricStats> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ObservationBucket
+ */
+export declare class ObservationBucket extends Message<ObservationBucket> {
+  /**
+   * @generated from field: string start = 1;
+   */
+  start: string;
+
+  /**
+   * @generated from field: int32 samples = 2;
+   */
+  samples: number;
+
+  /**
+   * @generated from field: string point = 3;
+   */
+  point: string;
+
+  /**
+   * @generated from field: string provider = 4;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: stormhunter.MetricStats temp = 5;
+   */
+  temp?: MetricStats;
+
+  /**
+   * @generated from field: stormhunter.MetricStats humidity = 6;
+   */
+  humidity?: MetricStats;
+
+  /**
+   * @generated from field: stormhunter.MetricStats wind_kmh = 7;
+   */
+  windKmh?: MetricStats;
+
+  /**
+   * @generated from field: stormhunter.MetricStats pressure = 8;
+   */
+  pressure?: MetricStats;
+
+  constructor(data?: PartialMessage<ObservationBucket>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ObservationBucket";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObservationBucket;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObservationBucket;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObservationBucket;
+
+  static equals(a: ObservationBucket | PlainMessage<ObservationBucket> | undefined, b: ObservationBucket | PlainMessage<ObservationBucket> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.GetObservationsResponse
+ */
+export declare class GetObservationsResponse extends Message<GetObservationsResponse> {
+  /**
+   * @generated from field: string region = 1;
+   */
+  region: string;
+
+  /**
+   * @generated from field: stormhunter.Bucket bucket = 2;
+   */
+  bucket: Bucket;
+
+  /**
+   * @generated from field: repeated stormhunter.ObservationBucket buckets = 3;
+   */
+  buckets: ObservationBucket[];
+
+  /**
+   * @generated from field: string next_page_token = 4;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<GetObservationsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.GetObservationsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetObservationsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetObservationsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetObservationsResponse;
+
+  static equals(a: GetObservationsResponse | PlainMessage<GetObservationsResponse> | undefined, b: GetObservationsResponse | PlainMessage<GetObservationsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.Storm
+ */
+export declare class Storm extends Message<Storm> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string region = 2;
+   */
+  region: string;
+
+  /**
+   * @generated from field: stormhunter.StormStatus status = 3;
+   */
+  status: StormStatus;
+
+  /**
+   * @generated from field: string started_at = 4;
+   */
+  startedAt: string;
+
+  /**
+   * @generated from field: string ended_at = 5;
+   */
+  endedAt: string;
+
+  /**
+   * @generated from field: string updated_at = 6;
+   */
+  updatedAt: string;
+
+  /**
+   * @generated from field: float lat = 7;
+   */
+  lat: number;
+
+  /**
+   * @generated from field: float lon = 8;
+   */
+  lon: number;
+
+  /**
+   * @generated from field: float wind_kmh = 9;
+   */
+  windKmh: number;
+
+  /**
+   * @generated from field: float peak_wind_kmh = 10;
+   */
+  peakWindKmh: number;
+
+  /**
+   * @generated from field: string peak_at = 11;
+   */
+  peakAt: string;
+
+  /**
+   * @generated from field: float min_pressure = 12;
+   */
+  minPressure: number;
+
+  /**
+   * @generated from field: int32 beaufort = 13;
+   */
+  beaufort: number;
+
+  /**
+   * @generated from field: int32 category = 14;
+   */
+  category: number;
+
+  /**
+   * @generated from field: stormhunter.Severity severity = 15;
+   */
+  severity: Severity;
+
+  /**
+   * Прогноз на +1, +3, +6 и +12 ч как GeoJSON FeatureCollection: конус неопределённости (Polygon),
+   * линия прогноза (LineString) и ожидаемые положения (Point). Только в GetStorm и событиях открытых штормов
+   *
+   * @generated from field: google.protobuf.Struct forecast = 16;
+   */
+  forecast?: Struct;
+
+  /**
+   * @generated from field: string name = 17;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string source = 18;
+   */
+  source: string;
+
+  constructor(data?: PartialMessage<Storm>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.Storm";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Storm;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Storm;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Storm;
+
+  static equals(a: Storm | PlainMessage<Storm> | undefined, b: Storm | PlainMessage<Storm> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.GetStormRequest
+ */
+export declare class GetStormRequest extends Message<GetStormRequest> {
+  /**
+   * @generated from field: string storm_id = 1;
+   */
+  stormId: string;
+
+  constructor(data?: PartialMessage<GetStormRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.GetStormRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStormRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStormRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStormRequest;
+
+  static equals(a: GetStormRequest | PlainMessage<GetStormRequest> | undefined, b: GetStormRequest | PlainMessage<GetStormRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListStormsRequest
+ */
+export declare class ListStormsRequest extends Message<ListStormsRequest> {
+  /**
+   * @generated from field: stormhunter.StormStatus status = 1;
+   */
+  status: StormStatus;
+
+  /**
+   * @generated from field: string region = 2;
+   */
+  region: string;
+
+  /**
+   * @generated from field: string from = 3;
+   */
+  from: string;
+
+  /**
+   * @generated from field: string to = 4;
+   */
+  to: string;
+
+  /**
+   * @generated from field: int32 min_category = 5;
+   */
+  minCategory: number;
+
+  /**
+   * @generated from field: int32 page_size = 6;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string source = 7;
+   */
+  source: string;
+
+  constructor(data?: PartialMessage<ListStormsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListStormsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStormsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListStormsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListStormsRequest;
+
+  static equals(a: ListStormsRequest | PlainMessage<ListStormsRequest> | undefined, b: ListStormsRequest | PlainMessage<ListStormsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListStormsResponse
+ */
+export declare class ListStormsResponse extends Message<ListStormsResponse> {
+  /**
+   * @generated from field: repeated stormhunter.Storm storms = 1;
+   */
+  storms: Storm[];
+
+  constructor(data?: PartialMessage<ListStormsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListStormsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStormsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListStormsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListStormsResponse;
+
+  static equals(a: ListStormsResponse | PlainMessage<ListStormsResponse> | undefined, b: ListStormsResponse | PlainMessage<ListStormsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.GetStormTrackRequest
+ */
+export declare class GetStormTrackRequest extends Message<GetStormTrackRequest> {
+  /**
+   * @generated from field: string storm_id = 1;
+   */
+  stormId: string;
+
+  constructor(data?: PartialMessage<GetStormTrackRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.GetStormTrackRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStormTrackRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStormTrackRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStormTrackRequest;
+
+  static equals(a: GetStormTrackRequest | PlainMessage<GetStormTrackRequest> | undefined, b: GetStormTrackRequest | PlainMessage<GetStormTrackRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.GetStormTrackResponse
+ */
+export declare class GetStormTrackResponse extends Message<GetStormTrackResponse> {
+  /**
+   * GeoJSON Feature: LineString в порядке [lon, lat] (Point для трека из одной точки) и свойства шторма.
+   * Свойства вершин — параллельные массивы в properties.coordinateProperties
+   *
+   * @generated from field: google.protobuf.Struct feature = 1;
+   */
+  feature?: Struct;
+
+  constructor(data?: PartialMessage<GetStormTrackResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.GetStormTrackResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStormTrackResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStormTrackResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStormTrackResponse;
+
+  static equals(a: GetStormTrackResponse | PlainMessage<GetStormTrackResponse> | undefined, b: GetStormTrackResponse | PlainMessage<GetStormTrackResponse> | undefined): boolean;
+}
+
+/**
+ * Например wind_kmh > 90 в Atlantic два обновления подряд или humidity +20 за 30 минут
+ *
+ * @generated from message stormhunter.AlertRule
+ */
+export declare class AlertRule extends Message<AlertRule> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string region = 3;
+   */
+  region: string;
+
+  /**
+   * @generated from field: stormhunter.AlertMetric metric = 4;
+   */
+  metric: AlertMetric;
+
+  /**
+   * @generated from field: stormhunter.AlertOperator operator = 5;
+   */
+  operator: AlertOperator;
+
+  /**
+   * @generated from field: double value = 6;
+   */
+  value: number;
+
+  /**
+   * @generated from field: int32 for_updates = 7;
+   */
+  forUpdates: number;
+
+  /**
+   * @generated from field: int32 window_seconds = 8;
+   */
+  windowSeconds: number;
+
+  /**
+   * @generated from field: int32 cooldown_seconds = 9;
+   */
+  cooldownSeconds: number;
+
+  /**
+   * @generated from field: bool enabled = 10;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: string created_at = 11;
+   */
+  createdAt: string;
+
+  constructor(data?: PartialMessage<AlertRule>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.AlertRule";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertRule;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertRule;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertRule;
+
+  static equals(a: AlertRule | PlainMessage<AlertRule> | undefined, b: AlertRule | PlainMessage<AlertRule> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.AlertEvent
+ */
+export declare class AlertEvent extends Message<AlertEvent> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: int64 rule_id = 2;
+   */
+  ruleId: bigint;
+
+  /**
+   * @generated from field: string rule_name = 3;
+   */
+  ruleName: string;
+
+  /**
+   * @generated from field: string region = 4;
+   */
+  region: string;
+
+  /**
+   * @generated from field: stormhunter.AlertState state = 5;
+   */
+  state: AlertState;
+
+  /**
+   * @generated from field: double value = 6;
+   */
+  value: number;
+
+  /**
+   * @generated from field: string message = 7;
+   */
+  message: string;
+
+  /**
+   * @generated from field: uint64 sequence = 8;
+   */
+  sequence: bigint;
+
+  /**
+   * @generated from field: string occurred_at = 9;
+   */
+  occurredAt: string;
+
+  constructor(data?: PartialMessage<AlertEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.AlertEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertEvent;
+
+  static equals(a: AlertEvent | PlainMessage<AlertEvent> | undefined, b: AlertEvent | PlainMessage<AlertEvent> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.CreateAlertRuleRequest
+ */
+export declare class CreateAlertRuleRequest extends Message<CreateAlertRuleRequest> {
+  /**
+   * @generated from field: stormhunter.AlertRule rule = 1;
+   */
+  rule?: AlertRule;
+
+  constructor(data?: PartialMessage<CreateAlertRuleRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.CreateAlertRuleRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlertRuleRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlertRuleRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlertRuleRequest;
+
+  static equals(a: CreateAlertRuleRequest | PlainMessage<CreateAlertRuleRequest> | undefined, b: CreateAlertRuleRequest | PlainMessage<CreateAlertRuleRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListAlertRulesRequest
+ */
+export declare class ListAlertRulesRequest extends Message<ListAlertRulesRequest> {
+  constructor(data?: PartialMessage<ListAlertRulesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListAlertRulesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAlertRulesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAlertRulesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAlertRulesRequest;
+
+  static equals(a: ListAlertRulesRequest | PlainMessage<ListAlertRulesRequest> | undefined, b: ListAlertRulesRequest | PlainMessage<ListAlertRulesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListAlertRulesResponse
+ */
+export declare class ListAlertRulesResponse extends Message<ListAlertRulesResponse> {
+  /**
+   * @generated from field: repeated stormhunter.AlertRule rules = 1;
+   */
+  rules: AlertRule[];
+
+  constructor(data?: PartialMessage<ListAlertRulesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListAlertRulesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAlertRulesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAlertRulesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAlertRulesResponse;
+
+  static equals(a: ListAlertRulesResponse | PlainMessage<ListAlertRulesResponse> | undefined, b: ListAlertRulesResponse | PlainMessage<ListAlertRulesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.DeleteAlertRuleRequest
+ */
+export declare class DeleteAlertRuleRequest extends Message<DeleteAlertRuleRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<DeleteAlertRuleRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.DeleteAlertRuleRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRuleRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRuleRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRuleRequest;
+
+  static equals(a: DeleteAlertRuleRequest | PlainMessage<DeleteAlertRuleRequest> | undefined, b: DeleteAlertRuleRequest | PlainMessage<DeleteAlertRuleRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.DeleteAlertRuleResponse
+ */
+export declare class DeleteAlertRuleResponse extends Message<DeleteAlertRuleResponse> {
+  constructor(data?: PartialMessage<DeleteAlertRuleResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.DeleteAlertRuleResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRuleResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRuleResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRuleResponse;
+
+  static equals(a: DeleteAlertRuleResponse | PlainMessage<DeleteAlertRuleResponse> | undefined, b: DeleteAlertRuleResponse | PlainMessage<DeleteAlertRuleResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListAlertEventsRequest
+ */
+export declare class ListAlertEventsRequest extends Message<ListAlertEventsRequest> {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  constructor(data?: PartialMessage<ListAlertEventsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListAlertEventsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAlertEventsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAlertEventsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAlertEventsRequest;
+
+  static equals(a: ListAlertEventsRequest | PlainMessage<ListAlertEventsRequest> | undefined, b: ListAlertEventsRequest | PlainMessage<ListAlertEventsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListAlertEventsResponse
+ */
+export declare class ListAlertEventsResponse extends Message<ListAlertEventsResponse> {
+  /**
+   * @generated from field: repeated stormhunter.AlertEvent events = 1;
+   */
+  events: AlertEvent[];
+
+  constructor(data?: PartialMessage<ListAlertEventsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListAlertEventsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAlertEventsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAlertEventsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAlertEventsResponse;
+
+  static equals(a: ListAlertEventsResponse | PlainMessage<ListAlertEventsResponse> | undefined, b: ListAlertEventsResponse | PlainMessage<ListAlertEventsResponse> | undefined): boolean;
+}
+
+/**
+ * Адрес, на который POST-запросом отправляются оповещения и события штормов.
+ * Тело подписывается: X-Storm-Signature = sha256=<hex HMAC-SHA256(secret, "<X-Storm-Timestamp>.<тело>")>
+ *
+ * @generated from message stormhunter.Webhook
+ */
+export declare class Webhook extends Message<Webhook> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: bool alerts = 3;
+   */
+  alerts: boolean;
+
+  /**
+   * @generated from field: bool storms = 4;
+   */
+  storms: boolean;
+
+  /**
+   * @generated from field: string region = 5;
+   */
+  region: string;
+
+  /**
+   * @generated from field: string secret = 6;
+   */
+  secret: string;
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: bool zones = 8;
+   */
+  zones: boolean;
+
+  constructor(data?: PartialMessage<Webhook>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.Webhook";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Webhook;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Webhook;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Webhook;
+
+  static equals(a: Webhook | PlainMessage<Webhook> | undefined, b: Webhook | PlainMessage<Webhook> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.CreateWebhookRequest
+ */
+export declare class CreateWebhookRequest extends Message<CreateWebhookRequest> {
+  /**
+   * @generated from field: stormhunter.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  constructor(data?: PartialMessage<CreateWebhookRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.CreateWebhookRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookRequest;
+
+  static equals(a: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined, b: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListWebhooksRequest
+ */
+export declare class ListWebhooksRequest extends Message<ListWebhooksRequest> {
+  constructor(data?: PartialMessage<ListWebhooksRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListWebhooksRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhooksRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhooksRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhooksRequest;
+
+  static equals(a: ListWebhooksRequest | PlainMessage<ListWebhooksRequest> | undefined, b: ListWebhooksRequest | PlainMessage<ListWebhooksRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListWebhooksResponse
+ */
+export declare class ListWebhooksResponse extends Message<ListWebhooksResponse> {
+  /**
+   * @generated from field: repeated stormhunter.Webhook webhooks = 1;
+   */
+  webhooks: Webhook[];
+
+  constructor(data?: PartialMessage<ListWebhooksResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListWebhooksResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhooksResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhooksResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhooksResponse;
+
+  static equals(a: ListWebhooksResponse | PlainMessage<ListWebhooksResponse> | undefined, b: ListWebhooksResponse | PlainMessage<ListWebhooksResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.DeleteWebhookRequest
+ */
+export declare class DeleteWebhookRequest extends Message<DeleteWebhookRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<DeleteWebhookRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.DeleteWebhookRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookRequest;
+
+  static equals(a: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined, b: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.DeleteWebhookResponse
+ */
+export declare class DeleteWebhookResponse extends Message<DeleteWebhookResponse> {
+  constructor(data?: PartialMessage<DeleteWebhookResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.DeleteWebhookResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookResponse;
+
+  static equals(a: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined, b: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.LatLon
+ */
+export declare class LatLon extends Message<LatLon> {
+  /**
+   * @generated from field: double lat = 1;
+   */
+  lat: number;
+
+  /**
+   * @generated from field: double lon = 2;
+   */
+  lon: number;
+
+  constructor(data?: PartialMessage<LatLon>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.LatLon";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LatLon;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LatLon;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LatLon;
+
+  static equals(a: LatLon | PlainMessage<LatLon> | undefined, b: LatLon | PlainMessage<LatLon> | undefined): boolean;
+}
+
+/**
+ * Зона наблюдения: круг (center и radius_km) или многоугольник (polygon, без повтора первой вершины).
+ * Событие приходит, когда в зону входит или из неё выходит шторм или точка самого сильного ветра региона
+ *
+ * @generated from message stormhunter.WatchZone
+ */
+export declare class WatchZone extends Message<WatchZone> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: stormhunter.LatLon center = 3;
+   */
+  center?: LatLon;
+
+  /**
+   * @generated from field: double radius_km = 4;
+   */
+  radiusKm: number;
+
+  /**
+   * @generated from field: repeated stormhunter.LatLon polygon = 5;
+   */
+  polygon: LatLon[];
+
+  /**
+   * @generated from field: float min_wind_kmh = 6;
+   */
+  minWindKmh: number;
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt: string;
+
+  constructor(data?: PartialMessage<WatchZone>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.WatchZone";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchZone;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchZone;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchZone;
+
+  static equals(a: WatchZone | PlainMessage<WatchZone> | undefined, b: WatchZone | PlainMessage<WatchZone> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.WatchZoneEvent
+ */
+export declare class WatchZoneEvent extends Message<WatchZoneEvent> {
+  /**
+   * @generated from field: int64 zone_id = 1;
+   */
+  zoneId: bigint;
+
+  /**
+   * @generated from field: string zone_name = 2;
+   */
+  zoneName: string;
+
+  /**
+   * @generated from field: string event = 3;
+   */
+  event: string;
+
+  /**
+   * @generated from field: string source = 4;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string storm_id = 5;
+   */
+  stormId: string;
+
+  /**
+   * @generated from field: string region = 6;
+   */
+  region: string;
+
+  /**
+   * @generated from field: stormhunter.LatLon position = 7;
+   */
+  position?: LatLon;
+
+  /**
+   * @generated from field: float wind_kmh = 8;
+   */
+  windKmh: number;
+
+  /**
+   * @generated from field: string occurred_at = 9;
+   */
+  occurredAt: string;
+
+  constructor(data?: PartialMessage<WatchZoneEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.WatchZoneEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchZoneEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchZoneEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchZoneEvent;
+
+  static equals(a: WatchZoneEvent | PlainMessage<WatchZoneEvent> | undefined, b: WatchZoneEvent | PlainMessage<WatchZoneEvent> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.CreateWatchZoneRequest
+ */
+export declare class CreateWatchZoneRequest extends Message<CreateWatchZoneRequest> {
+  /**
+   * @generated from field: stormhunter.WatchZone zone = 1;
+   */
+  zone?: WatchZone;
+
+  constructor(data?: PartialMessage<CreateWatchZoneRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.CreateWatchZoneRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWatchZoneRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWatchZoneRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWatchZoneRequest;
+
+  static equals(a: CreateWatchZoneRequest | PlainMessage<CreateWatchZoneRequest> | undefined, b: CreateWatchZoneRequest | PlainMessage<CreateWatchZoneRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListWatchZonesRequest
+ */
+export declare class ListWatchZonesRequest extends Message<ListWatchZonesRequest> {
+  constructor(data?: PartialMessage<ListWatchZonesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListWatchZonesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWatchZonesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWatchZonesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWatchZonesRequest;
+
+  static equals(a: ListWatchZonesRequest | PlainMessage<ListWatchZonesRequest> | undefined, b: ListWatchZonesRequest | PlainMessage<ListWatchZonesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.ListWatchZonesResponse
+ */
+export declare class ListWatchZonesResponse extends Message<ListWatchZonesResponse> {
+  /**
+   * @generated from field: repeated stormhunter.WatchZone zones = 1;
+   */
+  zones: WatchZone[];
+
+  constructor(data?: PartialMessage<ListWatchZonesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.ListWatchZonesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWatchZonesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWatchZonesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWatchZonesResponse;
+
+  static equals(a: ListWatchZonesResponse | PlainMessage<ListWatchZonesResponse> | undefined, b: ListWatchZonesResponse | PlainMessage<ListWatchZonesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.DeleteWatchZoneRequest
+ */
+export declare class DeleteWatchZoneRequest extends Message<DeleteWatchZoneRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<DeleteWatchZoneRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.DeleteWatchZoneRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWatchZoneRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWatchZoneRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWatchZoneRequest;
+
+  static equals(a: DeleteWatchZoneRequest | PlainMessage<DeleteWatchZoneRequest> | undefined, b: DeleteWatchZoneRequest | PlainMessage<DeleteWatchZoneRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.DeleteWatchZoneResponse
+ */
+export declare class DeleteWatchZoneResponse extends Message<DeleteWatchZoneResponse> {
+  constructor(data?: PartialMessage<DeleteWatchZoneResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.DeleteWatchZoneResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWatchZoneResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWatchZoneResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWatchZoneResponse;
+
+  static equals(a: DeleteWatchZoneResponse | PlainMessage<DeleteWatchZoneResponse> | undefined, b: DeleteWatchZoneResponse | PlainMessage<DeleteWatchZoneResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.PlanInterceptRequest
+ */
+export declare class PlanInterceptRequest extends Message<PlanInterceptRequest> {
+  /**
+   * @generated from field: string storm_id = 1;
+   */
+  stormId: string;
+
+  /**
+   * @generated from field: stormhunter.LatLon position = 2;
+   */
+  position?: LatLon;
+
+  /**
+   * @generated from field: double speed_kmh = 3;
+   */
+  speedKmh: number;
+
+  /**
+   * @generated from field: int32 track_points = 4;
+   */
+  trackPoints: number;
+
+  constructor(data?: PartialMessage<PlanInterceptRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.PlanInterceptRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanInterceptRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanInterceptRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanInterceptRequest;
+
+  static equals(a: PlanInterceptRequest | PlainMessage<PlanInterceptRequest> | undefined, b: PlanInterceptRequest | PlainMessage<PlanInterceptRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.StormMotion
+ */
+export declare class StormMotion extends Message<StormMotion> {
+  /**
+   * @generated from field: double speed_kmh = 1;
+   */
+  speedKmh: number;
+
+  /**
+   * @generated from field: double heading_deg = 2;
+   */
+  headingDeg: number;
+
+  /**
+   * @generated from field: int32 points = 3;
+   */
+  points: number;
+
+  constructor(data?: PartialMessage<StormMotion>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.StormMotion";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StormMotion;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StormMotion;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StormMotion;
+
+  static equals(a: StormMotion | PlainMessage<StormMotion> | undefined, b: StormMotion | PlainMessage<StormMotion> | undefined): boolean;
+}
+
+/**
+ * @generated from message stormhunter.PlanInterceptResponse
+ */
+export declare class PlanInterceptResponse extends Message<PlanInterceptResponse> {
+  /**
+   * @generated from field: string storm_id = 1;
+   */
+  stormId: string;
+
+  /**
+   * @generated from field: stormhunter.LatLon storm_position = 2;
+   */
+  stormPosition?: LatLon;
+
+  /**
+   * @generated from field: string observed_at = 3;
+   */
+  observedAt: string;
+
+  /**
+   * @generated from field: double distance_km = 4;
+   */
+  distanceKm: number;
+
+  /**
+   * @generated from field: double bearing_deg = 5;
+   */
+  bearingDeg: number;
+
+  /**
+   * @generated from field: stormhunter.StormMotion motion = 6;
+   */
+  motion?: StormMotion;
+
+  /**
+   * @generated from field: bool reachable = 7;
+   */
+  reachable: boolean;
+
+  /**
+   * @generated from field: stormhunter.LatLon intercept = 8;
+   */
+  intercept?: LatLon;
+
+  /**
+   * @generated from field: string intercept_at = 9;
+   */
+  interceptAt: string;
+
+  /**
+   * @generated from field: double travel_km = 10;
+   */
+  travelKm: number;
+
+  /**
+   * @generated from field: double heading_deg = 11;
+   */
+  headingDeg: number;
+
+  constructor(data?: PartialMessage<PlanInterceptResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "stormhunter.PlanInterceptResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanInterceptResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanInterceptResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanInterceptResponse;
+
+  static equals(a: PlanInterceptResponse | PlainMessage<PlanInterceptResponse> | undefined, b: PlanInterceptResponse | PlainMessage<PlanInterceptResponse> | undefined): boolean;
+}
+
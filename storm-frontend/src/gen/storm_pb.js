@@ -3,7 +3,94 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { proto3 } from "@bufbuild/protobuf";
+import { proto3, Struct } from "@bufbuild/protobuf";
+
+/**
+ * Степень опасности ветра по шкале Бофорта и категории урагана (пороги настраиваются)
+ *
+ * @generated from enum stormhunter.Severity
+ */
+export const Severity = /*@__PURE__*/ proto3.makeEnum(
+  "stormhunter.Severity",
+  [
+    {no: 0, name: "SEVERITY_NONE", localName: "NONE"},
+    {no: 1, name: "SEVERITY_LOW", localName: "LOW"},
+    {no: 2, name: "SEVERITY_MODERATE", localName: "MODERATE"},
+    {no: 3, name: "SEVERITY_HIGH", localName: "HIGH"},
+    {no: 4, name: "SEVERITY_EXTREME", localName: "EXTREME"},
+  ],
+);
+
+/**
+ * Размер интервала агрегации замеров
+ *
+ * @generated from enum stormhunter.Bucket
+ */
+export const Bucket = /*@__PURE__*/ proto3.makeEnum(
+  "stormhunter.Bucket",
+  [
+    {no: 0, name: "BUCKET_RAW", localName: "RAW"},
+    {no: 1, name: "BUCKET_1M", localName: "1M"},
+    {no: 2, name: "BUCKET_10M", localName: "10M"},
+    {no: 3, name: "BUCKET_1H", localName: "1H"},
+  ],
+);
+
+/**
+ * @generated from enum stormhunter.StormStatus
+ */
+export const StormStatus = /*@__PURE__*/ proto3.makeEnum(
+  "stormhunter.StormStatus",
+  [
+    {no: 0, name: "STORM_STATUS_ANY", localName: "ANY"},
+    {no: 1, name: "STORM_STATUS_ACTIVE", localName: "ACTIVE"},
+    {no: 2, name: "STORM_STATUS_CLOSED", localName: "CLOSED"},
+  ],
+);
+
+/**
+ * Показатель обновления региона, по которому срабатывает правило
+ *
+ * @generated from enum stormhunter.AlertMetric
+ */
+export const AlertMetric = /*@__PURE__*/ proto3.makeEnum(
+  "stormhunter.AlertMetric",
+  [
+    {no: 0, name: "ALERT_METRIC_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "ALERT_METRIC_WIND_KMH", localName: "WIND_KMH"},
+    {no: 2, name: "ALERT_METRIC_TEMP", localName: "TEMP"},
+    {no: 3, name: "ALERT_METRIC_HUMIDITY", localName: "HUMIDITY"},
+    {no: 4, name: "ALERT_METRIC_PRESSURE", localName: "PRESSURE"},
+  ],
+);
+
+/**
+ * @generated from enum stormhunter.AlertOperator
+ */
+export const AlertOperator = /*@__PURE__*/ proto3.makeEnum(
+  "stormhunter.AlertOperator",
+  [
+    {no: 0, name: "ALERT_OPERATOR_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "ALERT_OPERATOR_GT", localName: "GT"},
+    {no: 2, name: "ALERT_OPERATOR_GTE", localName: "GTE"},
+    {no: 3, name: "ALERT_OPERATOR_LT", localName: "LT"},
+    {no: 4, name: "ALERT_OPERATOR_LTE", localName: "LTE"},
+    {no: 5, name: "ALERT_OPERATOR_RISE", localName: "RISE"},
+    {no: 6, name: "ALERT_OPERATOR_FALL", localName: "FALL"},
+  ],
+);
+
+/**
+ * @generated from enum stormhunter.AlertState
+ */
+export const AlertState = /*@__PURE__*/ proto3.makeEnum(
+  "stormhunter.AlertState",
+  [
+    {no: 0, name: "ALERT_STATE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "ALERT_STATE_FIRING", localName: "FIRING"},
+    {no: 2, name: "ALERT_STATE_RESOLVED", localName: "RESOLVED"},
+  ],
+);
 
 /**
  * @generated from message stormhunter.StartStreamRequest
@@ -13,6 +100,61 @@ export const StartStreamRequest = /*@__PURE__*/ proto3.makeMessageType(
   () => [
     { no: 1, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "resume_from", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.StopStreamRequest
+ */
+export const StopStreamRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.StopStreamRequest",
+  () => [
+    { no: 1, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.StopStreamResponse
+ */
+export const StopStreamResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.StopStreamResponse",
+  () => [
+    { no: 1, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stopped_streams", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "no_local_streams", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListSubscriptionsRequest
+ */
+export const ListSubscriptionsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListSubscriptionsRequest",
+  [],
+);
+
+/**
+ * @generated from message stormhunter.RegionSubscriptions
+ */
+export const RegionSubscriptions = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.RegionSubscriptions",
+  () => [
+    { no: 1, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "subscribers", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "lingering", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "local_subscribers", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "generation", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListSubscriptionsResponse
+ */
+export const ListSubscriptionsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListSubscriptionsResponse",
+  () => [
+    { no: 1, name: "regions", kind: "message", T: RegionSubscriptions, repeated: true },
   ],
 );
 
@@ -29,6 +171,509 @@ export const WeatherData = /*@__PURE__*/ proto3.makeMessageType(
     { no: 5, name: "lon", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
     { no: 6, name: "wind_kmh", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 7, name: "timestamp", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "heartbeat", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "point", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "beaufort", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "category", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "severity", kind: "enum", T: proto3.getEnumType(Severity) },
+    { no: 13, name: "classification", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListRegionsRequest
+ */
+export const ListRegionsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListRegionsRequest",
+  [],
+);
+
+/**
+ * @generated from message stormhunter.SamplePoint
+ */
+export const SamplePoint = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.SamplePoint",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "lat", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 3, name: "lon", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.Region
+ */
+export const Region = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.Region",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "interval_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "points", kind: "message", T: SamplePoint, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListRegionsResponse
+ */
+export const ListRegionsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListRegionsResponse",
+  () => [
+    { no: 1, name: "regions", kind: "message", T: Region, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.GetObservationsRequest
+ */
+export const GetObservationsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.GetObservationsRequest",
+  () => [
+    { no: 1, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "bucket", kind: "enum", T: proto3.getEnumType(Bucket) },
+    { no: 5, name: "point", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.MetricStats
+ */
+export const MetricStats = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.MetricStats",
+  () => [
+    { no: 1, name: "min", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "max", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "avg", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ObservationBucket
+ */
+export const ObservationBucket = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ObservationBucket",
+  () => [
+    { no: 1, name: "start", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "samples", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "point", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "temp", kind: "message", T: MetricStats },
+    { no: 6, name: "humidity", kind: "message", T: MetricStats },
+    { no: 7, name: "wind_kmh", kind: "message", T: MetricStats },
+    { no: 8, name: "pressure", kind: "message", T: MetricStats },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.GetObservationsResponse
+ */
+export const GetObservationsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.GetObservationsResponse",
+  () => [
+    { no: 1, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "bucket", kind: "enum", T: proto3.getEnumType(Bucket) },
+    { no: 3, name: "buckets", kind: "message", T: ObservationBucket, repeated: true },
+    { no: 4, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.Storm
+ */
+export const Storm = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.Storm",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(StormStatus) },
+    { no: 4, name: "started_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "ended_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "lat", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 8, name: "lon", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 9, name: "wind_kmh", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 10, name: "peak_wind_kmh", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 11, name: "peak_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "min_pressure", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 13, name: "beaufort", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 14, name: "category", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 15, name: "severity", kind: "enum", T: proto3.getEnumType(Severity) },
+    { no: 16, name: "forecast", kind: "message", T: Struct },
+    { no: 17, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.GetStormRequest
+ */
+export const GetStormRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.GetStormRequest",
+  () => [
+    { no: 1, name: "storm_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListStormsRequest
+ */
+export const ListStormsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListStormsRequest",
+  () => [
+    { no: 1, name: "status", kind: "enum", T: proto3.getEnumType(StormStatus) },
+    { no: 2, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "min_category", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListStormsResponse
+ */
+export const ListStormsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListStormsResponse",
+  () => [
+    { no: 1, name: "storms", kind: "message", T: Storm, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.GetStormTrackRequest
+ */
+export const GetStormTrackRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.GetStormTrackRequest",
+  () => [
+    { no: 1, name: "storm_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.GetStormTrackResponse
+ */
+export const GetStormTrackResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.GetStormTrackResponse",
+  () => [
+    { no: 1, name: "feature", kind: "message", T: Struct },
+  ],
+);
+
+/**
+ * Например wind_kmh > 90 в Atlantic два обновления подряд или humidity +20 за 30 минут
+ *
+ * @generated from message stormhunter.AlertRule
+ */
+export const AlertRule = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.AlertRule",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "metric", kind: "enum", T: proto3.getEnumType(AlertMetric) },
+    { no: 5, name: "operator", kind: "enum", T: proto3.getEnumType(AlertOperator) },
+    { no: 6, name: "value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "for_updates", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "window_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "cooldown_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.AlertEvent
+ */
+export const AlertEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.AlertEvent",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "rule_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "rule_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "state", kind: "enum", T: proto3.getEnumType(AlertState) },
+    { no: 6, name: "value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 9, name: "occurred_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.CreateAlertRuleRequest
+ */
+export const CreateAlertRuleRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.CreateAlertRuleRequest",
+  () => [
+    { no: 1, name: "rule", kind: "message", T: AlertRule },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListAlertRulesRequest
+ */
+export const ListAlertRulesRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListAlertRulesRequest",
+  [],
+);
+
+/**
+ * @generated from message stormhunter.ListAlertRulesResponse
+ */
+export const ListAlertRulesResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListAlertRulesResponse",
+  () => [
+    { no: 1, name: "rules", kind: "message", T: AlertRule, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.DeleteAlertRuleRequest
+ */
+export const DeleteAlertRuleRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.DeleteAlertRuleRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.DeleteAlertRuleResponse
+ */
+export const DeleteAlertRuleResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.DeleteAlertRuleResponse",
+  [],
+);
+
+/**
+ * @generated from message stormhunter.ListAlertEventsRequest
+ */
+export const ListAlertEventsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListAlertEventsRequest",
+  () => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListAlertEventsResponse
+ */
+export const ListAlertEventsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListAlertEventsResponse",
+  () => [
+    { no: 1, name: "events", kind: "message", T: AlertEvent, repeated: true },
+  ],
+);
+
+/**
+ * Адрес, на который POST-запросом отправляются оповещения и события штормов.
+ * Тело подписывается: X-Storm-Signature = sha256=<hex HMAC-SHA256(secret, "<X-Storm-Timestamp>.<тело>")>
+ *
+ * @generated from message stormhunter.Webhook
+ */
+export const Webhook = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.Webhook",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "alerts", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "storms", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "zones", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.CreateWebhookRequest
+ */
+export const CreateWebhookRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.CreateWebhookRequest",
+  () => [
+    { no: 1, name: "webhook", kind: "message", T: Webhook },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListWebhooksRequest
+ */
+export const ListWebhooksRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListWebhooksRequest",
+  [],
+);
+
+/**
+ * @generated from message stormhunter.ListWebhooksResponse
+ */
+export const ListWebhooksResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListWebhooksResponse",
+  () => [
+    { no: 1, name: "webhooks", kind: "message", T: Webhook, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.DeleteWebhookRequest
+ */
+export const DeleteWebhookRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.DeleteWebhookRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.DeleteWebhookResponse
+ */
+export const DeleteWebhookResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.DeleteWebhookResponse",
+  [],
+);
+
+/**
+ * @generated from message stormhunter.LatLon
+ */
+export const LatLon = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.LatLon",
+  () => [
+    { no: 1, name: "lat", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "lon", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ],
+);
+
+/**
+ * Зона наблюдения: круг (center и radius_km) или многоугольник (polygon, без повтора первой вершины).
+ * Событие приходит, когда в зону входит или из неё выходит шторм или точка самого сильного ветра региона
+ *
+ * @generated from message stormhunter.WatchZone
+ */
+export const WatchZone = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.WatchZone",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "center", kind: "message", T: LatLon },
+    { no: 4, name: "radius_km", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "polygon", kind: "message", T: LatLon, repeated: true },
+    { no: 6, name: "min_wind_kmh", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.WatchZoneEvent
+ */
+export const WatchZoneEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.WatchZoneEvent",
+  () => [
+    { no: 1, name: "zone_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "zone_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "storm_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "position", kind: "message", T: LatLon },
+    { no: 8, name: "wind_kmh", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 9, name: "occurred_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.CreateWatchZoneRequest
+ */
+export const CreateWatchZoneRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.CreateWatchZoneRequest",
+  () => [
+    { no: 1, name: "zone", kind: "message", T: WatchZone },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.ListWatchZonesRequest
+ */
+export const ListWatchZonesRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListWatchZonesRequest",
+  [],
+);
+
+/**
+ * @generated from message stormhunter.ListWatchZonesResponse
+ */
+export const ListWatchZonesResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.ListWatchZonesResponse",
+  () => [
+    { no: 1, name: "zones", kind: "message", T: WatchZone, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.DeleteWatchZoneRequest
+ */
+export const DeleteWatchZoneRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.DeleteWatchZoneRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.DeleteWatchZoneResponse
+ */
+export const DeleteWatchZoneResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.DeleteWatchZoneResponse",
+  [],
+);
+
+/**
+ * @generated from message stormhunter.PlanInterceptRequest
+ */
+export const PlanInterceptRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.PlanInterceptRequest",
+  () => [
+    { no: 1, name: "storm_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "position", kind: "message", T: LatLon },
+    { no: 3, name: "speed_kmh", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "track_points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.StormMotion
+ */
+export const StormMotion = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.StormMotion",
+  () => [
+    { no: 1, name: "speed_kmh", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "heading_deg", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message stormhunter.PlanInterceptResponse
+ */
+export const PlanInterceptResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "stormhunter.PlanInterceptResponse",
+  () => [
+    { no: 1, name: "storm_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "storm_position", kind: "message", T: LatLon },
+    { no: 3, name: "observed_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "distance_km", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "bearing_deg", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "motion", kind: "message", T: StormMotion },
+    { no: 7, name: "reachable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "intercept", kind: "message", T: LatLon },
+    { no: 9, name: "intercept_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "travel_km", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 11, name: "heading_deg", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ],
 );
 
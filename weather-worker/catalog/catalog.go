@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+)
+
+// Точка замера внутри региона
+type Point struct {
+	Name string  `yaml:"name" json:"name"`
+	Lat  float32 `yaml:"lat" json:"lat"`
+	Lon  float32 `yaml:"lon" json:"lon"`
+}
+
+// Регион каталога
+type Region struct {
+	ID       string        `yaml:"id" json:"id"`
+	Name     string        `yaml:"name" json:"name"`
+	Provider string        `yaml:"provider" json:"provider"`
+	Interval time.Duration `yaml:"interval" json:"interval"`
+	Points   []Point       `yaml:"points" json:"points"`
+}
+
+// Каталог регионов, загруженный из YAML- или JSON-файла (копия каталога storm-backend)
+type Catalog struct {
+	regions []Region
+	byID    map[string]Region
+}
+
+type catalogFile struct {
+	Regions []Region `yaml:"regions"`
+}
+
+// Загрузка каталога по пути из REGIONS_FILE (по умолчанию regions.yaml).
+// Переменная REGIONS (например "Atlantic,Pacific") ограничивает список включённых регионов
+func LoadFromEnv() (*Catalog, error) {
+	path := os.Getenv("REGIONS_FILE")
+	if path == "" {
+		path = "regions.yaml"
+	}
+	var enabled []string
+	if raw := os.Getenv("REGIONS"); raw != "" {
+		enabled = strings.Split(raw, ",")
+	}
+	return Load(path, enabled)
+}
+
+// Загрузка каталога из файла; JSON читается тем же разборщиком, так как является подмножеством YAML
+func Load(path string, enabled []string) (*Catalog, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read region catalogue %s: %w", path, err)
+	}
+
+	var file catalogFile
+	if err := yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse region catalogue %s: %w", path, err)
+	}
+
+	allowed := make(map[string]bool, len(enabled))
+	for _, id := range enabled {
+		if id = strings.TrimSpace(id); id != "" {
+			allowed[id] = true
+		}
+	}
+
+	c := &Catalog{byID: make(map[string]Region)}
+	for _, region := range file.Regions {
+		if err := region.validate(); err != nil {
+			return nil, fmt.Errorf("invalid region catalogue %s: %w", path, err)
+		}
+		if _, dup := c.byID[region.ID]; dup {
+			return nil, fmt.Errorf("invalid region catalogue %s: duplicate region %q", path, region.ID)
+		}
+		if len(allowed) > 0 && !allowed[region.ID] {
+			continue
+		}
+		c.regions = append(c.regions, region)
+		c.byID[region.ID] = region
+	}
+	for id := range allowed {
+		if _, ok := c.byID[id]; !ok {
+			return nil, fmt.Errorf("region %q from REGIONS is not in catalogue %s", id, path)
+		}
+	}
+
+	log.Info().Str("path", path).Int("regions", len(c.regions)).Msg("Region catalogue loaded")
+	return c, nil
+}
+
+// Поиск региона по идентификатору
+func (c *Catalog) Get(id string) (Region, bool) {
+	region, ok := c.byID[id]
+	return region, ok
+}
+
+// Все включённые регионы в порядке каталога
+func (c *Catalog) Regions() []Region {
+	return c.regions
+}
+
+func (r *Region) validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("region without id")
+	}
+	if r.Name == "" {
+		r.Name = r.ID
+	}
+	if r.Interval <= 0 {
+		r.Interval = 10 * time.Second
+	}
+	if len(r.Points) == 0 {
+		return fmt.Errorf("region %q has no sample points", r.ID)
+	}
+	for _, p := range r.Points {
+		if p.Lat < -90 || p.Lat > 90 || p.Lon < -180 || p.Lon > 180 {
+			return fmt.Errorf("region %q: point %q has invalid coordinates %.4f,%.4f", r.ID, p.Name, p.Lat, p.Lon)
+		}
+	}
+	return nil
+}
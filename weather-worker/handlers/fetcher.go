@@ -4,26 +4,43 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"os"
+	"strconv"
 	"time"
 
+	"weatherworker/catalog"
+	"weatherworker/providers"
+
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 )
 
-// Структура для парсинга JSON-ответа
-type WeatherResponse struct {
-	Coord struct {
-		Lat float32 `json:"lat"`
-		Lon float32 `json:"lon"`
-	} `json:"coord"`
-	Main struct {
-		Temp     float32 `json:"temp"`
-		Humidity int     `json:"humidity"`
-	} `json:"main"`
-	Wind struct {
-		Speed float32 `json:"speed"`
-	} `json:"wind"`
+// Ключи Redis для досылки пропущенных обновлений после переподключения клиента
+const (
+	sequenceKey = "storm_seq:%s"    // Счётчик номеров обновлений региона
+	streamKey   = "storm_stream:%s" // Redis Stream последних обновлений; ID записи — <номер>-0
+)
+
+// Сколько последних обновлений региона хранит Redis Stream (UPDATE_STREAM_MAXLEN, по умолчанию 1000)
+var updateStreamMaxLen = streamMaxLenFromEnv()
+
+func streamMaxLenFromEnv() int64 {
+	raw := os.Getenv("UPDATE_STREAM_MAXLEN")
+	if raw == "" {
+		return 1000
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || n < 1 {
+		log.Warn().Str("value", raw).Msg("Invalid UPDATE_STREAM_MAXLEN, using default")
+		return 1000
+	}
+	return n
+}
+
+// Замер в конкретной точке региона
+type PointObservation struct {
+	Point string
+	providers.Observation
 }
 
 // Структура для сериализации данных в кэш
@@ -33,99 +50,106 @@ type CacheData struct {
 	Temp      float32 `json:"temp"`
 	Humidity  int     `json:"humidity"`
 	WindKmH   int     `json:"wind_kmh"`
+	Pressure  float32 `json:"pressure,omitempty"` // Давление, гПа
+	Provider  string  `json:"provider,omitempty"` // Источник замера
+	Point     string  `json:"point,omitempty"`    // Точка региона, попавшая в обновление
 	Timestamp string  `json:"timestamp"`
-}
-
-func FetchAndCacheWeather(ctx context.Context, region, apiKey string, rdb *redis.Client) error {
-	city := regionToCity(region) // Преобразование региона в конкретный город
-	if city == "" {
-		log.Error().Str("region", region).Msg("invalid city for region")
-		return fmt.Errorf("invalid city for region %s", region)
-	}
-
-	url := fmt.Sprintf("https://api.openweathermap.org/data/2.5/weather?q=%s&appid=%s", city, apiKey) // URL для получения данных
+	Sequence  uint64  `json:"sequence,omitempty"` // Номер обновления региона, растёт на 1
 
-	client := &http.Client{ // Создание HTTP-клиента для запроса
-		Timeout: 10 * time.Second,
-	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil) // Создание GET-запроса на OpenWeather API
-	if err != nil {
-		log.Error().Err(err).Str("region", region).Msg("failed to create weather request")
-		return fmt.Errorf("failed to create weather request for %s: %w", region, err)
-	}
+	ObservedAt string `json:"observed_at,omitempty"` // Время замера по данным источника
+}
 
-	resp, err := client.Do(req) // Получение ответа с данными о погоде
-	if err != nil {
-		log.Error().Err(err).Str("region", region).Msg("failed to fetch weather")
-		return fmt.Errorf("failed to fetch weather for %s: %w", region, err)
+// Опрос всех точек региона. В кэш и в канал обновлений попадает точка с самым сильным ветром,
+// а все успешные замеры возвращаются вызывающему
+func FetchAndCacheWeather(ctx context.Context, region catalog.Region, source providers.Provider, rdb *redis.Client) ([]PointObservation, error) {
+	var observations []PointObservation
+	for _, point := range region.Points {
+		data, err := source.Fetch(ctx, providers.Location{Name: point.Name, Lat: point.Lat, Lon: point.Lon}) // Получение нормализованного замера от источника
+		if err != nil {
+			log.Error().Err(err).Str("region", region.ID).Str("point", point.Name).Str("provider", source.Name()).Msg("failed to fetch weather")
+			continue
+		}
+		observations = append(observations, PointObservation{Point: point.Name, Observation: *data})
 	}
-	defer resp.Body.Close() // Закрытие тела ответа
-
-	if resp.StatusCode != http.StatusOK { // Проверка статуса ответа
-		log.Error().Int("status", resp.StatusCode).Str("region", region).Msg("unexpected response from OpenWeather")
-		return fmt.Errorf("unexpected response from OpenWeather for %s: status %d", region, resp.StatusCode)
+	if len(observations) == 0 {
+		return nil, fmt.Errorf("failed to fetch weather for any point of %s", region.ID)
 	}
 
-	var data WeatherResponse                                         // Переменная для ответа с данными о погоде
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil { // Декодирование ответа в подготовленную структуру
-		log.Error().Err(err).Str("region", region).Msg("failed to decode weather response")
-		return fmt.Errorf("failed to decode weather response for %s: %w", region, err)
+	strongest := observations[0]
+	for _, obs := range observations[1:] {
+		if obs.WindKmH > strongest.WindKmH {
+			strongest = obs
+		}
 	}
+	data := strongest.Observation
 
-	cacheKey := fmt.Sprintf("storm:%s", region) // Формирование ключа для Redis
+	cacheKey := fmt.Sprintf("storm:%s", region.ID) // Формирование ключа для Redis
 
 	cacheData := CacheData{
-		Lat:       data.Coord.Lat,
-		Lon:       data.Coord.Lon,
-		Temp:      data.Main.Temp - 273.15, // перевод из Кельвинов в °C
-		Humidity:  data.Main.Humidity,
-		WindKmH:   int(data.Wind.Speed * 3.6), // Перевод м/с в км/ч
+		Lat:       data.Lat,
+		Lon:       data.Lon,
+		Temp:      data.TempC,
+		Humidity:  data.Humidity,
+		WindKmH:   int(data.WindKmH),
+		Pressure:  data.PressureHPa,
+		Provider:  data.Provider,
+		Point:     strongest.Point,
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
+
+		ObservedAt: data.ObservedAt.Format(time.RFC3339),
 	}
 
-	if data.Main.Temp == 0 && data.Main.Humidity == 0 {
-		log.Warn().Str("region", region).Msg("Received empty weather data, skipping cache/publish")
-		return fmt.Errorf("empty weather data")
+	// Номер обновления: по нему backend досылает клиенту пропущенное из Redis Stream
+	seq, err := rdb.Incr(ctx, fmt.Sprintf(sequenceKey, region.ID)).Result()
+	if err != nil {
+		log.Error().Err(err).Str("region", region.ID).Msg("failed to allocate update sequence")
+		return observations, fmt.Errorf("failed to allocate update sequence for %s: %w", region.ID, err)
 	}
+	cacheData.Sequence = uint64(seq)
 
 	value, err := json.Marshal(cacheData)
 	if err != nil {
-		log.Error().Err(err).Str("region", region).Msg("failed to marshal cache data")
-		return fmt.Errorf("failed to marshal cache data for %s: %w", region, err)
+		log.Error().Err(err).Str("region", region.ID).Msg("failed to marshal cache data")
+		return observations, fmt.Errorf("failed to marshal cache data for %s: %w", region.ID, err)
 	}
 
 	if err := rdb.Set(ctx, cacheKey, value, 5*time.Minute).Err(); err != nil { // Кэширование данных на 5 минут
-		log.Error().Err(err).Str("region", region).Msg("failed to cache weather")
-		return fmt.Errorf("failed to cache weather for %s: %w", region, err)
+		log.Error().Err(err).Str("region", region.ID).Msg("failed to cache weather")
+		return observations, fmt.Errorf("failed to cache weather for %s: %w", region.ID, err)
+	}
+
+	// Запись в поток до публикации: всё, что получили подписчики канала, можно дослать из потока
+	err = rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: fmt.Sprintf(streamKey, region.ID),
+		MaxLen: updateStreamMaxLen,
+		Approx: true,
+		ID:     fmt.Sprintf("%d-0", seq),
+		Values: map[string]interface{}{"data": value},
+	}).Err()
+	if err != nil {
+		log.Error().Err(err).Str("region", region.ID).Uint64("sequence", cacheData.Sequence).Msg("failed to append weather update to stream")
 	}
 
 	// Публикуем обновление для стримов
-	channel := fmt.Sprintf("storm_updates:%s", region)
+	channel := fmt.Sprintf("storm_updates:%s", region.ID)
 	if err := rdb.Publish(ctx, channel, value).Err(); err != nil {
-		log.Error().Err(err).Str("region", region).Msg("failed to publish weather update")
+		log.Error().Err(err).Str("region", region.ID).Msg("failed to publish weather update")
 	} else {
-		log.Info().Str("region", region).Msg("Published weather update to Redis channel")
+		log.Info().Str("region", region.ID).Msg("Published weather update to Redis channel")
 	}
 
 	log.Info(). // Логирование успешного обновления данных
-			Str("region", region).
-			Float32("lat", data.Coord.Lat).
-			Float32("lon", data.Coord.Lon).
-			Float32("wind_m_s", data.Wind.Speed).
-			Int("humidity", data.Main.Humidity).
-			Float32("temp", data.Main.Temp).
+			Str("region", region.ID).
+			Str("point", strongest.Point).
+			Str("provider", data.Provider).
+			Float32("lat", data.Lat).
+			Float32("lon", data.Lon).
+			Float32("wind_kmh", data.WindKmH).
+			Int("humidity", data.Humidity).
+			Float32("temp", data.TempC).
+			Int("points", len(observations)).
 			Str("timestamp", cacheData.Timestamp).
+			Uint64("sequence", cacheData.Sequence).
 			Msg("weather updated and cached")
-	return nil
-}
-
-func regionToCity(region string) string { // Для конкретного региона возвращаем конкретный город
-	switch region {
-	case "Atlantic":
-		return "Miami"
-	case "Pacific":
-		return "Honolulu"
-	default:
-		return "London"
-	}
+	return observations, nil
 }
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const ObservationsQueue = "weather_observations" // Очередь замеров для записи временного ряда в storm-backend
+
+// Замер в формате сообщения очереди weather_observations
+type ObservationMessage struct {
+	Region     string    `json:"region"`
+	Point      string    `json:"point"`
+	Lat        float32   `json:"lat"`
+	Lon        float32   `json:"lon"`
+	Temp       float32   `json:"temp"`
+	Humidity   int       `json:"humidity"`
+	WindKmH    float32   `json:"wind_kmh"`
+	Pressure   float32   `json:"pressure,omitempty"`
+	Provider   string    `json:"provider"`
+	ObservedAt time.Time `json:"observed_at"`
+}
+
+// Все замеры одного цикла опроса региона; цикл — единица обработки для детектора штормов
+type ObservationCycle struct {
+	Region       string               `json:"region"`
+	FetchedAt    time.Time            `json:"fetched_at"` // Время цикла опроса, общее для всех точек
+	Observations []ObservationMessage `json:"observations"`
+}
+
+// Публикация замеров в отдельном канале, чтобы не мешать потреблению задач
+type observationPublisher struct {
+	mu sync.Mutex
+	ch *amqp.Channel
+}
+
+func newObservationPublisher(conn *amqp.Connection) (*observationPublisher, error) {
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open observations channel: %w", err)
+	}
+	_, err = ch.QueueDeclare(
+		ObservationsQueue, // Имя
+		true,              // Durable
+		false,             // Auto-delete
+		false,             // Exclusive
+		false,             // No-wait
+		nil,               // Args
+	)
+	if err != nil {
+		ch.Close()
+		return nil, fmt.Errorf("failed to declare observations queue: %w", err)
+	}
+	return &observationPublisher{ch: ch}, nil
+}
+
+// Отправка всех замеров одного опроса региона одним сообщением
+func (p *observationPublisher) publish(ctx context.Context, region string, fetchedAt time.Time, observations []PointObservation) {
+	if len(observations) == 0 {
+		return
+	}
+	cycle := ObservationCycle{Region: region, FetchedAt: fetchedAt.UTC()}
+	for _, obs := range observations {
+		observedAt := obs.ObservedAt
+		if observedAt.IsZero() { // Источник не сообщил время замера
+			observedAt = fetchedAt
+		}
+		cycle.Observations = append(cycle.Observations, ObservationMessage{
+			Region:     region,
+			Point:      obs.Point,
+			Lat:        obs.Lat,
+			Lon:        obs.Lon,
+			Temp:       obs.TempC,
+			Humidity:   obs.Humidity,
+			WindKmH:    obs.WindKmH,
+			Pressure:   obs.PressureHPa,
+			Provider:   obs.Provider,
+			ObservedAt: observedAt.UTC(),
+		})
+	}
+
+	body, err := json.Marshal(cycle)
+	if err != nil {
+		log.Error().Err(err).Str("region", region).Msg("Failed to marshal observations")
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	err = p.ch.PublishWithContext(ctx, "", ObservationsQueue, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent, // Замеры должны пережить перезапуск RabbitMQ
+		Body:         body,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("region", region).Int("points", len(observations)).Msg("Failed to publish observations")
+	}
+}
+
+func (p *observationPublisher) close() {
+	p.ch.Close()
+}
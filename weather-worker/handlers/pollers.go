@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"weatherworker/catalog"
+	"weatherworker/providers"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	ownerKey = "storm:poller-owner:%s" // Какой воркер сейчас опрашивает регион
+	ownerTTL = 30 * time.Second        // Минимальная аренда владения; продлевается на каждом тике опроса
+)
+
+// Продление аренды, только если регион всё ещё принадлежит этому воркеру
+var renewOwnerScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Освобождение региона, только если он принадлежит этому воркеру
+var releaseOwnerScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// Горутина опроса одного региона
+type poller struct {
+	cancel     context.CancelFunc
+	generation int64
+}
+
+// Запущенные горутины опроса по регионам
+type pollers struct {
+	rdb      *redis.Client
+	sink     *observationPublisher // Куда отправляются все замеры опроса
+	workerID string
+	mu       sync.Mutex
+	active   map[string]*poller
+}
+
+func newPollers(rdb *redis.Client, sink *observationPublisher) *pollers {
+	host, _ := os.Hostname()
+	return &pollers{
+		rdb:      rdb,
+		sink:     sink,
+		workerID: fmt.Sprintf("%s-%d", host, os.Getpid()),
+		active:   make(map[string]*poller),
+	}
+}
+
+// Запуск опроса региона. Регион опрашивается только одним воркером:
+// владение закрепляется ключом в Redis, и задача игнорируется, если регион уже занят
+func (p *pollers) start(ctx context.Context, entry catalog.Region, source providers.Provider, generation int64) bool {
+	region := entry.ID
+	ttl := ownerTTL // Аренда должна пережить хотя бы пару интервалов опроса
+	if 3*entry.Interval > ttl {
+		ttl = 3 * entry.Interval
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if current, ok := p.active[region]; ok {
+		if generation > current.generation { // Опрос уже идёт — запоминаем более новое поколение
+			current.generation = generation
+		}
+		return false
+	}
+
+	key := fmt.Sprintf(ownerKey, region)
+	acquired, err := p.rdb.SetNX(ctx, key, p.workerID, ttl).Result()
+	if err != nil {
+		log.Error().Err(err).Str("region", region).Msg("Failed to acquire region ownership")
+		return false
+	}
+	if !acquired {
+		log.Debug().Str("region", region).Msg("Region is already polled by another worker")
+		return false
+	}
+
+	pollCtx, cancel := context.WithCancel(ctx)
+	current := &poller{cancel: cancel, generation: generation}
+	p.active[region] = current
+
+	go func() {
+		defer p.forget(region, current)
+		ticker := time.NewTicker(entry.Interval) // период обновления из каталога
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-pollCtx.Done():
+				log.Info().Str("region", region).Msg("Polling stopped")
+				return
+			case <-ticker.C:
+				renewed, err := renewOwnerScript.Run(pollCtx, p.rdb, []string{key}, p.workerID, ttl.Milliseconds()).Int64()
+				if err == nil && renewed == 0 { // Аренда истекла и регион забрал другой воркер
+					log.Warn().Str("region", region).Msg("Lost region ownership, stopping polling")
+					return
+				}
+				fetchedAt := time.Now()
+				observations, err := FetchAndCacheWeather(pollCtx, entry, source, p.rdb)
+				if err != nil {
+					log.Error().Err(err).Str("region", region).Msg("Failed to fetch and cache weather")
+				}
+				p.sink.publish(pollCtx, region, fetchedAt, observations)
+			}
+		}
+	}()
+	return true
+}
+
+// Остановка опроса региона. Команда с поколением старше текущего опроса игнорируется:
+// она могла прийти после нового start из-за разных маршрутов доставки
+func (p *pollers) stop(ctx context.Context, region string, generation int64) bool {
+	p.mu.Lock()
+	current, ok := p.active[region]
+	if !ok || (generation != 0 && generation < current.generation) {
+		p.mu.Unlock()
+		return false
+	}
+	current.cancel()
+	delete(p.active, region)
+	p.mu.Unlock()
+
+	p.releaseOwner(ctx, region)
+	return true
+}
+
+// Удаление записи о завершившейся горутине (если регион уже не перезапущен заново)
+func (p *pollers) forget(region string, finished *poller) {
+	finished.cancel()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.active[region] == finished {
+		delete(p.active, region)
+		p.releaseOwner(context.Background(), region)
+	}
+}
+
+func (p *pollers) releaseOwner(ctx context.Context, region string) {
+	if err := releaseOwnerScript.Run(ctx, p.rdb, []string{fmt.Sprintf(ownerKey, region)}, p.workerID).Err(); err != nil && err != redis.Nil {
+		log.Error().Err(err).Str("region", region).Msg("Failed to release region ownership")
+	}
+}
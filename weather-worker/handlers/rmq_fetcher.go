@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"time"
+
+	"weatherworker/catalog"
+	"weatherworker/providers"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
@@ -13,12 +15,22 @@ import (
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+// Действия, которые присылает storm-backend
+const (
+	TaskActionStart = "start"
+	TaskActionStop  = "stop"
+)
+
 type WeatherTask struct {
 	Region string `json:"region"`
 	UserID string `json:"user_id"`
+	Action string `json:"action,omitempty"` // Пустое значение трактуется как start
+
+	// Поколение опроса: stop со старым поколением не останавливает более новый опрос
+	Generation int64 `json:"generation,omitempty"`
 }
 
-func RunWorker(ctx context.Context, apiKey string, rdb *redis.Client) error {
+func RunWorker(ctx context.Context, regions *catalog.Catalog, selector *providers.Selector, rdb *redis.Client) error {
 	// Подключение к RabbitMQ
 	conn, err := amqp.Dial(os.Getenv("RABBITMQ_URL"))
 	if err != nil {
@@ -46,6 +58,36 @@ func RunWorker(ctx context.Context, apiKey string, rdb *redis.Client) error {
 		return fmt.Errorf("failed to declare queue: %w", err)
 	}
 
+	// Fanout-обменник для команд остановки: каждая команда доходит до всех воркеров
+	err = ch.ExchangeDeclare(
+		"weather_control", // Имя
+		"fanout",          // Тип
+		true,              // Durable
+		false,             // Auto-delete
+		false,             // Internal
+		false,             // No-wait
+		nil,               // Args
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare control exchange: %w", err)
+	}
+
+	// Собственная временная очередь воркера для команд управления
+	controlQueue, err := ch.QueueDeclare(
+		"",    // Имя генерирует сервер
+		false, // Durable
+		true,  // Auto-delete
+		true,  // Exclusive
+		false, // No-wait
+		nil,   // Args
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare control queue: %w", err)
+	}
+	if err := ch.QueueBind(controlQueue.Name, "", "weather_control", false, nil); err != nil {
+		return fmt.Errorf("failed to bind control queue: %w", err)
+	}
+
 	// Ограничиваем число сообщений (для одного worker'а)
 	err = ch.Qos(1, 0, false)
 	if err != nil {
@@ -66,6 +108,26 @@ func RunWorker(ctx context.Context, apiKey string, rdb *redis.Client) error {
 		return fmt.Errorf("failed to register consumer: %w", err)
 	}
 
+	controls, err := ch.Consume(
+		controlQueue.Name, // Очередь
+		"",                // Consumer tag
+		true,              // Auto ACK
+		true,              // Exclusive
+		false,             // No-local
+		false,             // No-wait
+		nil,               // Args
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register control consumer: %w", err)
+	}
+
+	sink, err := newObservationPublisher(conn)
+	if err != nil {
+		return err
+	}
+	defer sink.close()
+
+	active := newPollers(rdb, sink)
 	log.Info().Msg("Worker started, waiting for messages...")
 
 	// Обрабатываем сообщения с учётом контекста для graceful shutdown
@@ -86,29 +148,45 @@ func RunWorker(ctx context.Context, apiKey string, rdb *redis.Client) error {
 				continue
 			}
 
-			log.Info().
-				Str("region", task.Region).
-				Str("user_id", task.UserID).
-				Msg("Starting continuous weather updates")
-
 			d.Ack(false)
 
-			go func(region string) {
-				ticker := time.NewTicker(10 * time.Second) // период обновления
-				defer ticker.Stop()
-
-				for {
-					select {
-					case <-ctx.Done():
-						log.Info().Str("region", region).Msg("Worker context cancelled, stopping updates")
-						return
-					case <-ticker.C:
-						if err := FetchAndCacheWeather(ctx, region, apiKey, rdb); err != nil {
-							log.Error().Err(err).Str("region", region).Msg("Failed to fetch and cache weather")
-						}
-					}
-				}
-			}(task.Region)
+			if task.Action == TaskActionStop { // Команда остановки могла прийти и в общую очередь
+				active.stop(ctx, task.Region, task.Generation)
+				continue
+			}
+			entry, ok := regions.Get(task.Region) // Неизвестные регионы отклоняются, а не подменяются другим городом
+			if !ok {
+				log.Error().Str("region", task.Region).Msg("Rejected task for unknown region")
+				continue
+			}
+			source, err := selector.For(entry.Provider) // Источник погоды, настроенный для региона
+			if err != nil {
+				log.Error().Err(err).Str("region", task.Region).Msg("No weather provider for region")
+				continue
+			}
+			if active.start(ctx, entry, source, task.Generation) {
+				log.Info().
+					Str("region", task.Region).
+					Str("user_id", task.UserID).
+					Str("provider", source.Name()).
+					Msg("Starting continuous weather updates")
+			}
+		case d, ok := <-controls:
+			if !ok {
+				return fmt.Errorf("control channel closed unexpectedly")
+			}
+
+			var task WeatherTask
+			if err := json.Unmarshal(d.Body, &task); err != nil {
+				log.Error().Err(err).Msg("Failed to unmarshal control message")
+				continue
+			}
+			if task.Action == TaskActionStop && active.stop(ctx, task.Region, task.Generation) {
+				log.Info().
+					Str("region", task.Region).
+					Str("user_id", task.UserID).
+					Msg("Stopping weather updates on request")
+			}
 		}
 	}
 }
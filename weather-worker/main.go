@@ -5,7 +5,9 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"weatherworker/catalog"
 	"weatherworker/handlers"
+	"weatherworker/providers"
 	"weatherworker/redisdb"
 
 	"github.com/rs/zerolog"
@@ -42,12 +44,25 @@ func main() {
 		os.Exit(0)
 	}()
 
+	// Каталог регионов: точки замера, интервалы и источники
+	regions, err := catalog.LoadFromEnv()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load region catalogue")
+	}
+
+	// Источники погоды и их выбор по регионам
+	selector, err := providers.NewSelectorFromEnv(providers.NewRegistryFromEnv())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to configure weather providers")
+	}
+
 	// Запуск воркера
-	apiKey := os.Getenv("OPENWEATHER_API_KEY")
-	if apiKey == "" {
-		log.Fatal().Msg("Failed to load environment variables: apiKey")
+	for _, region := range regions.Regions() { // Проверка, что источник каждого региона настроен
+		if _, err := selector.For(region.Provider); err != nil {
+			log.Fatal().Err(err).Str("region", region.ID).Msg("Invalid provider in region catalogue")
+		}
 	}
-	if err := handlers.RunWorker(ctx, apiKey, Rdb); err != nil {
+	if err := handlers.RunWorker(ctx, regions, selector, Rdb); err != nil {
 		log.Fatal().Err(err).Msg("Worker failed")
 	}
 }
@@ -0,0 +1,101 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Структура для парсинга JSON-ответа в формате Open-Meteo (/v1/forecast?current=...)
+type openMeteoResponse struct {
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+	Current   struct {
+		Time             string   `json:"time"`
+		Temperature      *float32 `json:"temperature_2m"`
+		RelativeHumidity *float32 `json:"relative_humidity_2m"`
+		WindSpeed        *float32 `json:"wind_speed_10m"`
+		PressureMSL      *float32 `json:"pressure_msl"` // Приведено к уровню моря, как main.pressure у OpenWeather
+	} `json:"current"`
+}
+
+// Источник Open-Meteo или совместимый с ним сервер
+type OpenMeteo struct {
+	baseURL string
+	client  *http.Client
+}
+
+// baseURL позволяет указать собственный сервер с тем же API; пусто — публичный Open-Meteo
+func NewOpenMeteo(baseURL string) *OpenMeteo {
+	if baseURL == "" {
+		baseURL = "https://api.open-meteo.com/v1/forecast"
+	}
+	return &OpenMeteo{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *OpenMeteo) Name() string {
+	return "openmeteo"
+}
+
+func (p *OpenMeteo) Fetch(ctx context.Context, loc Location) (*Observation, error) {
+	if loc.Lat == 0 && loc.Lon == 0 {
+		return nil, fmt.Errorf("open-meteo requires coordinates, got none for %q", loc.Name)
+	}
+	query := url.Values{
+		"latitude":        {strconv.FormatFloat(float64(loc.Lat), 'f', 4, 32)},
+		"longitude":       {strconv.FormatFloat(float64(loc.Lon), 'f', 4, 32)},
+		"current":         {"temperature_2m,relative_humidity_2m,wind_speed_10m,pressure_msl"},
+		"wind_speed_unit": {"kmh"},
+		"timezone":        {"UTC"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create weather request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch weather: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response from Open-Meteo: status %d", resp.StatusCode)
+	}
+
+	var data openMeteoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode weather response: %w", err)
+	}
+	current := data.Current
+	if current.Temperature == nil || current.RelativeHumidity == nil || current.WindSpeed == nil {
+		return nil, fmt.Errorf("empty weather data")
+	}
+
+	observedAt := time.Now().UTC()
+	if parsed, err := time.Parse("2006-01-02T15:04", current.Time); err == nil { // Время в ISO 8601 без секунд, часовой пояс UTC
+		observedAt = parsed
+	}
+
+	obs := &Observation{
+		Lat:        data.Latitude,
+		Lon:        data.Longitude,
+		TempC:      *current.Temperature, // Уже в °C
+		Humidity:   int(*current.RelativeHumidity),
+		WindKmH:    *current.WindSpeed, // Уже в км/ч благодаря wind_speed_unit=kmh
+		ObservedAt: observedAt,
+		Provider:   p.Name(),
+	}
+	if current.PressureMSL != nil {
+		obs.PressureHPa = *current.PressureMSL
+	}
+	return obs, nil
+}
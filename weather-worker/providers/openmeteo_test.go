@@ -0,0 +1,98 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// Заглушка API: отдаёт body со статусом status и запоминает запрос
+func stubServer(t *testing.T, status int, body string) (*httptest.Server, *url.Values) {
+	t.Helper()
+	query := &url.Values{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return server, query
+}
+
+func near(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 0.01
+}
+
+func TestOpenMeteoFetch(t *testing.T) {
+	server, query := stubServer(t, http.StatusOK, `{
+		"latitude": 25.75, "longitude": -80.25,
+		"current": {"time": "2026-09-01T12:15", "temperature_2m": 29.4, "relative_humidity_2m": 78,
+			"wind_speed_10m": 41.8, "pressure_msl": 1008.6, "surface_pressure": 1007.9}
+	}`)
+
+	obs, err := NewOpenMeteo(server.URL).Fetch(context.Background(), Location{Name: "Miami", Lat: 25.7617, Lon: -80.1918})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if query.Get("latitude") != "25.7617" || query.Get("longitude") != "-80.1918" || query.Get("wind_speed_unit") != "kmh" || query.Get("timezone") != "UTC" {
+		t.Errorf("query = %v", *query)
+	}
+	if query.Get("current") != "temperature_2m,relative_humidity_2m,wind_speed_10m,pressure_msl" {
+		t.Errorf("current = %q", query.Get("current"))
+	}
+	// Давление на уровне моря, а не у поверхности
+	if !near(obs.TempC, 29.4) || obs.Humidity != 78 || !near(obs.WindKmH, 41.8) || !near(obs.PressureHPa, 1008.6) {
+		t.Errorf("observation = %+v", obs)
+	}
+	if obs.Lat != 25.75 || obs.Lon != -80.25 || obs.Provider != "openmeteo" {
+		t.Errorf("location = %v, %v from %s", obs.Lat, obs.Lon, obs.Provider)
+	}
+	if want := time.Date(2026, 9, 1, 12, 15, 0, 0, time.UTC); !obs.ObservedAt.Equal(want) {
+		t.Errorf("ObservedAt = %v, want %v", obs.ObservedAt, want)
+	}
+}
+
+func TestOpenMeteoMissingFields(t *testing.T) {
+	// Без давления замер остаётся полезным, нечитаемое время заменяется временем запроса
+	server, _ := stubServer(t, http.StatusOK, `{"latitude": 1, "longitude": 2,
+		"current": {"time": "yesterday", "temperature_2m": -3.5, "relative_humidity_2m": 40, "wind_speed_10m": 0}}`)
+	before := time.Now().UTC()
+	obs, err := NewOpenMeteo(server.URL).Fetch(context.Background(), Location{Lat: 1, Lon: 2})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if obs.PressureHPa != 0 || !near(obs.TempC, -3.5) || obs.WindKmH != 0 {
+		t.Errorf("observation = %+v", obs)
+	}
+	if obs.ObservedAt.Before(before) || obs.ObservedAt.After(time.Now().UTC()) {
+		t.Errorf("ObservedAt = %v, want the time of the request", obs.ObservedAt)
+	}
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		loc    Location
+	}{
+		{"no temperature", http.StatusOK, `{"current": {"time": "2026-09-01T12:00", "relative_humidity_2m": 40, "wind_speed_10m": 5}}`, Location{Lat: 1, Lon: 2}},
+		{"no wind", http.StatusOK, `{"current": {"time": "2026-09-01T12:00", "temperature_2m": 20, "relative_humidity_2m": 40}}`, Location{Lat: 1, Lon: 2}},
+		{"empty current", http.StatusOK, `{}`, Location{Lat: 1, Lon: 2}},
+		{"bad json", http.StatusOK, `{"current": `, Location{Lat: 1, Lon: 2}},
+		{"rate limited", http.StatusTooManyRequests, `{"error": true}`, Location{Lat: 1, Lon: 2}},
+		{"no coordinates", http.StatusOK, `{}`, Location{Name: "Miami"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := stubServer(t, tt.status, tt.body)
+			if obs, err := NewOpenMeteo(server.URL).Fetch(context.Background(), tt.loc); err == nil {
+				t.Errorf("Fetch = %+v, want an error", obs)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Структура для парсинга JSON-ответа OpenWeather (data/2.5/weather)
+type openWeatherResponse struct {
+	Coord struct {
+		Lat float32 `json:"lat"`
+		Lon float32 `json:"lon"`
+	} `json:"coord"`
+	Main struct {
+		Temp     float32 `json:"temp"`
+		Humidity int     `json:"humidity"`
+		Pressure float32 `json:"pressure"`
+	} `json:"main"`
+	Wind struct {
+		Speed float32 `json:"speed"`
+	} `json:"wind"`
+	Dt int64 `json:"dt"`
+}
+
+// Источник OpenWeather
+type OpenWeather struct {
+	apiKey  string
+	baseURL string
+	client  *http.Client
+}
+
+func NewOpenWeather(apiKey string) *OpenWeather {
+	return &OpenWeather{
+		apiKey:  apiKey,
+		baseURL: "https://api.openweathermap.org/data/2.5/weather",
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *OpenWeather) Name() string {
+	return "openweather"
+}
+
+func (p *OpenWeather) Fetch(ctx context.Context, loc Location) (*Observation, error) {
+	query := url.Values{"appid": {p.apiKey}}
+	if loc.Lat != 0 || loc.Lon != 0 { // Координаты точнее названия города
+		query.Set("lat", strconv.FormatFloat(float64(loc.Lat), 'f', 4, 32))
+		query.Set("lon", strconv.FormatFloat(float64(loc.Lon), 'f', 4, 32))
+	} else {
+		query.Set("q", loc.Name)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"?"+query.Encode(), nil) // Создание GET-запроса на OpenWeather API
+	if err != nil {
+		return nil, fmt.Errorf("failed to create weather request: %w", err)
+	}
+
+	resp, err := p.client.Do(req) // Получение ответа с данными о погоде
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch weather: %w", err)
+	}
+	defer resp.Body.Close() // Закрытие тела ответа
+
+	if resp.StatusCode != http.StatusOK { // Проверка статуса ответа
+		return nil, fmt.Errorf("unexpected response from OpenWeather: status %d", resp.StatusCode)
+	}
+
+	var data openWeatherResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode weather response: %w", err)
+	}
+	if data.Main.Temp == 0 && data.Main.Humidity == 0 {
+		return nil, fmt.Errorf("empty weather data")
+	}
+
+	observedAt := time.Now().UTC()
+	if data.Dt > 0 {
+		observedAt = time.Unix(data.Dt, 0).UTC()
+	}
+
+	return &Observation{
+		Lat:         data.Coord.Lat,
+		Lon:         data.Coord.Lon,
+		TempC:       data.Main.Temp - 273.15, // перевод из Кельвинов в °C
+		Humidity:    data.Main.Humidity,
+		WindKmH:     data.Wind.Speed * 3.6, // Перевод м/с в км/ч
+		PressureHPa: data.Main.Pressure,
+		ObservedAt:  observedAt,
+		Provider:    p.Name(),
+	}, nil
+}
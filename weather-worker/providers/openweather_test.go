@@ -0,0 +1,80 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestOpenWeather(baseURL string) *OpenWeather {
+	p := NewOpenWeather("test-key")
+	p.baseURL = baseURL
+	return p
+}
+
+func TestOpenWeatherFetch(t *testing.T) {
+	server, query := stubServer(t, http.StatusOK, `{
+		"coord": {"lat": 25.76, "lon": -80.19},
+		"main": {"temp": 302.55, "humidity": 78, "pressure": 1008, "grnd_level": 1007},
+		"wind": {"speed": 11.5},
+		"dt": 1788264900
+	}`)
+
+	obs, err := newTestOpenWeather(server.URL).Fetch(context.Background(), Location{Name: "Miami", Lat: 25.7617, Lon: -80.1918})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	// Координаты точнее названия, поэтому q не отправляется
+	if query.Get("appid") != "test-key" || query.Get("lat") != "25.7617" || query.Get("lon") != "-80.1918" || query.Has("q") {
+		t.Errorf("query = %v", *query)
+	}
+	// Кельвины в °C, м/с в км/ч; давление main.pressure — на уровне моря
+	if !near(obs.TempC, 29.4) || obs.Humidity != 78 || !near(obs.WindKmH, 41.4) || obs.PressureHPa != 1008 {
+		t.Errorf("observation = %+v", obs)
+	}
+	if obs.Lat != 25.76 || obs.Lon != -80.19 || obs.Provider != "openweather" {
+		t.Errorf("location = %v, %v from %s", obs.Lat, obs.Lon, obs.Provider)
+	}
+	if want := time.Date(2026, 9, 1, 12, 15, 0, 0, time.UTC); !obs.ObservedAt.Equal(want) {
+		t.Errorf("ObservedAt = %v, want %v", obs.ObservedAt, want)
+	}
+}
+
+func TestOpenWeatherMissingFields(t *testing.T) {
+	// Без dt, ветра и давления; поиск по названию, если координат нет
+	server, query := stubServer(t, http.StatusOK, `{"coord": {"lat": 51.51, "lon": -0.13}, "main": {"temp": 273.15, "humidity": 90}}`)
+	before := time.Now().UTC()
+	obs, err := newTestOpenWeather(server.URL).Fetch(context.Background(), Location{Name: "London"})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if query.Get("q") != "London" || query.Has("lat") || query.Has("lon") {
+		t.Errorf("query = %v", *query)
+	}
+	if obs.TempC != 0 || obs.Humidity != 90 || obs.WindKmH != 0 || obs.PressureHPa != 0 {
+		t.Errorf("observation = %+v", obs)
+	}
+	if obs.ObservedAt.Before(before) || obs.ObservedAt.After(time.Now().UTC()) {
+		t.Errorf("ObservedAt = %v, want the time of the request", obs.ObservedAt)
+	}
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"empty main", http.StatusOK, `{"coord": {"lat": 1, "lon": 2}, "dt": 1788264900}`},
+		{"bad json", http.StatusOK, `{"main": `},
+		{"unauthorized", http.StatusUnauthorized, `{"cod": 401}`},
+		{"rate limited", http.StatusTooManyRequests, `{"cod": 429}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := stubServer(t, tt.status, tt.body)
+			if obs, err := newTestOpenWeather(server.URL).Fetch(context.Background(), Location{Lat: 1, Lon: 2}); err == nil {
+				t.Errorf("Fetch = %+v, want an error", obs)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Нормализованный замер погоды, не зависящий от источника
+type Observation struct {
+	Lat         float32   // Широта точки замера
+	Lon         float32   // Долгота точки замера
+	TempC       float32   // Температура, °C
+	Humidity    int       // Относительная влажность, %
+	WindKmH     float32   // Скорость ветра, км/ч
+	PressureHPa float32   // Давление на уровне моря, гПа (0 — источник не сообщил)
+	ObservedAt  time.Time // Время замера по данным источника (UTC)
+	Provider    string    // Имя источника
+}
+
+// Точка, для которой запрашивается погода
+type Location struct {
+	Name string  // Название (город), если источник ищет по имени
+	Lat  float32 // Координаты точки
+	Lon  float32
+}
+
+// Источник погодных данных
+type Provider interface {
+	Name() string
+	Fetch(ctx context.Context, loc Location) (*Observation, error)
+}
+
+// Набор источников, доступных воркеру
+type Registry struct {
+	providers map[string]Provider
+}
+
+// Регистрация источников по переменным окружения:
+// OpenWeather — при наличии OPENWEATHER_API_KEY, Open-Meteo — всегда (ключ не нужен)
+func NewRegistryFromEnv() *Registry {
+	r := &Registry{providers: make(map[string]Provider)}
+	if apiKey := os.Getenv("OPENWEATHER_API_KEY"); apiKey != "" {
+		r.Register(NewOpenWeather(apiKey))
+	}
+	r.Register(NewOpenMeteo(os.Getenv("OPENMETEO_URL")))
+	return r
+}
+
+func (r *Registry) Register(p Provider) {
+	r.providers[p.Name()] = p
+}
+
+func (r *Registry) Get(name string) (Provider, bool) {
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// Цепочка источников: следующий используется, если предыдущий недоступен или ограничил запросы
+type Chain []Provider
+
+// Построение цепочки из списка имён вида "openweather|openmeteo"
+func (r *Registry) Chain(spec string) (Chain, error) {
+	var chain Chain
+	for _, name := range strings.Split(spec, "|") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		p, ok := r.Get(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown or unconfigured weather provider %q", name)
+		}
+		chain = append(chain, p)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("empty weather provider list")
+	}
+	return chain, nil
+}
+
+// Запрос по цепочке до первого успешного ответа
+func (c Chain) Fetch(ctx context.Context, loc Location) (*Observation, error) {
+	var errs []error
+	for _, p := range c {
+		obs, err := p.Fetch(ctx, loc)
+		if err == nil {
+			return obs, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+// Выбор источника по спецификации из каталога регионов
+type Selector struct {
+	registry    *Registry
+	defaultSpec string // Цепочка для регионов без явного источника
+}
+
+// WEATHER_PROVIDER — цепочка по умолчанию (например "openweather|openmeteo")
+func NewSelectorFromEnv(registry *Registry) (*Selector, error) {
+	s := &Selector{
+		registry:    registry,
+		defaultSpec: os.Getenv("WEATHER_PROVIDER"),
+	}
+	if s.defaultSpec == "" {
+		s.defaultSpec = "openmeteo"
+		if _, ok := registry.Get("openweather"); ok {
+			s.defaultSpec = "openweather|openmeteo"
+		}
+	}
+	if _, err := registry.Chain(s.defaultSpec); err != nil {
+		return nil, fmt.Errorf("invalid WEATHER_PROVIDER: %w", err)
+	}
+	return s, nil
+}
+
+// Источник (цепочка источников) по спецификации; пустая спецификация — цепочка по умолчанию
+func (s *Selector) For(spec string) (Provider, error) {
+	if spec == "" {
+		spec = s.defaultSpec
+	}
+	chain, err := s.registry.Chain(spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain) == 1 {
+		return chain[0], nil
+	}
+	return chain, nil
+}
+
+func (c Chain) Name() string {
+	names := make([]string, 0, len(c))
+	for _, p := range c {
+		names = append(names, p.Name())
+	}
+	return strings.Join(names, "|")
+}